@@ -0,0 +1,176 @@
+// Package analysisutil provides helpers shared by the analyzers of the module.
+package analysisutil
+
+import (
+	"bytes"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strconv"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ssa"
+)
+
+// Import paths of the module packages the analyzers know about.
+const (
+	OptPath = "github.com/sr9000/go-ptr-tools/opt"
+	PtrPath = "github.com/sr9000/go-ptr-tools/ptr"
+	RefPath = "github.com/sr9000/go-ptr-tools/ref"
+)
+
+// IsNamed tells whether typ is the named type path.name or its instantiation.
+func IsNamed(typ types.Type, path, name string) bool {
+	named, ok := types.Unalias(typ).(*types.Named)
+	if !ok {
+		return false
+	}
+
+	obj := named.Origin().Obj()
+
+	return obj.Pkg() != nil && obj.Pkg().Path() == path && obj.Name() == name
+}
+
+// TypeArg returns the first type argument of the instantiated named type, typ must be checked with IsNamed.
+func TypeArg(typ types.Type) types.Type {
+	return types.Unalias(typ).(*types.Named).TypeArgs().At(0) //nolint:forcetypeassert // checked by IsNamed
+}
+
+// IsFunc tells whether obj is the package level function path.name.
+func IsFunc(obj types.Object, path, name string) bool {
+	fn, ok := obj.(*types.Func)
+
+	return ok && fn.Pkg() != nil && fn.Pkg().Path() == path && fn.Name() == name &&
+		fn.Type().(*types.Signature).Recv() == nil //nolint:forcetypeassert // functions have signatures
+}
+
+// BuildSSA builds the package the way buildssa does and returns its source functions, including literals.
+// Analyzers call it for packages using the module only: a required buildssa runs for every package analyzed
+// for facts, including the standard library, which it may fail to build.
+func BuildSSA(pass *analysis.Pass) []*ssa.Function {
+	prog := ssa.NewProgram(pass.Fset, 0)
+
+	for _, pkg := range pass.Pkg.Imports() {
+		prog.CreatePackage(pkg, nil, nil, true)
+	}
+
+	prog.CreatePackage(pass.Pkg, pass.Files, pass.TypesInfo, false).Build()
+
+	var (
+		funcs   []*ssa.Function
+		addAnon func(fn *ssa.Function)
+	)
+
+	addAnon = func(fn *ssa.Function) {
+		funcs = append(funcs, fn)
+		for _, anon := range fn.AnonFuncs {
+			addAnon(anon)
+		}
+	}
+
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			if decl, ok := decl.(*ast.FuncDecl); ok {
+				if fn, ok := pass.TypesInfo.Defs[decl.Name].(*types.Func); ok {
+					addAnon(prog.FuncValue(fn))
+				}
+			}
+		}
+	}
+
+	return funcs
+}
+
+// Callee returns the function or method called by the call, including explicit instantiations, or nil.
+func Callee(info *types.Info, call *ast.CallExpr) types.Object {
+	fun := ast.Unparen(call.Fun)
+
+	switch f := fun.(type) {
+	case *ast.IndexExpr:
+		fun = f.X
+	case *ast.IndexListExpr:
+		fun = f.X
+	}
+
+	switch f := fun.(type) {
+	case *ast.Ident:
+		return info.Uses[f]
+	case *ast.SelectorExpr:
+		return info.Uses[f.Sel]
+	default:
+		return nil
+	}
+}
+
+// ImportName returns the name the file imports the package with, or false if it is not imported by name.
+func ImportName(pass *analysis.Pass, file *ast.File, path string) (string, bool) {
+	for _, spec := range file.Imports {
+		if p, _ := strconv.Unquote(spec.Path.Value); p != path {
+			continue
+		}
+
+		switch {
+		case spec.Name == nil:
+			return pass.TypesInfo.PkgNameOf(spec).Imported().Name(), true
+		case spec.Name.Name == "_" || spec.Name.Name == ".":
+			return "", false
+		default:
+			return spec.Name.Name, true
+		}
+	}
+
+	return "", false
+}
+
+// RequireImport returns the name the file imports the package with, and the edit adding the import if needed.
+func RequireImport(pass *analysis.Pass, file *ast.File, path, name string) (string, []analysis.TextEdit) {
+	if imported, ok := ImportName(pass, file, path); ok {
+		return imported, nil
+	}
+
+	spec := strconv.Quote(path)
+
+	for _, decl := range file.Decls {
+		if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.IMPORT && gen.Lparen.IsValid() {
+			return name, []analysis.TextEdit{{Pos: gen.Rparen, End: gen.Rparen, NewText: []byte("\t" + spec + "\n")}}
+		}
+	}
+
+	return name, []analysis.TextEdit{{Pos: file.Name.End(), End: file.Name.End(), NewText: []byte("\n\nimport " + spec)}}
+}
+
+// Source returns the source code of the node, or an empty string if the file is not available.
+func Source(pass *analysis.Pass, node ast.Node) string {
+	src, file := fileSource(pass, node.Pos())
+	if src == nil {
+		return ""
+	}
+
+	return string(src[file.Offset(node.Pos()):file.Offset(node.End())])
+}
+
+// Indent returns the whitespace preceding the node on its line.
+func Indent(pass *analysis.Pass, node ast.Node) string {
+	src, file := fileSource(pass, node.Pos())
+	if src == nil {
+		return ""
+	}
+
+	line := src[file.Offset(file.LineStart(file.Line(node.Pos()))):file.Offset(node.Pos())]
+
+	return string(line[:len(line)-len(bytes.TrimLeft(line, " \t"))])
+}
+
+func fileSource(pass *analysis.Pass, pos token.Pos) ([]byte, *token.File) {
+	file := pass.Fset.File(pos)
+	if file == nil {
+		return nil, nil
+	}
+
+	src, err := pass.ReadFile(file.Name())
+	if err != nil {
+		return nil, nil
+	}
+
+	return src, file
+}
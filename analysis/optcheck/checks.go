@@ -0,0 +1,263 @@
+package optcheck
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/sr9000/go-ptr-tools/analysis/internal/analysisutil"
+)
+
+// assign checks writes through Opt.Ptr() and Opt.Get() calls discarding ok.
+func (c *checker) assign(stmt *ast.AssignStmt) {
+	for _, lhs := range stmt.Lhs {
+		c.write(lhs, stmt)
+	}
+
+	if len(stmt.Lhs) != 2 || len(stmt.Rhs) != 1 || !isBlank(stmt.Lhs[1]) {
+		return
+	}
+
+	recv, ok := c.optMethod(stmt.Rhs[0], "Get")
+	if !ok {
+		return
+	}
+
+	diag := analysis.Diagnostic{
+		Pos:     stmt.Pos(),
+		End:     stmt.End(),
+		Message: "ok result of Opt.Get is discarded, missing options may hold any value",
+	}
+
+	value, typ := c.optValue(recv)
+	if zero, ok := zeroValue(analysisutil.TypeArg(typ)); ok && !isBlank(stmt.Lhs[0]) {
+		if name, ok := analysisutil.ImportName(c.pass, c.file, analysisutil.OptPath); ok {
+			diag.SuggestedFixes = []analysis.SuggestedFix{{
+				Message: "Use opt.Else",
+				TextEdits: []analysis.TextEdit{{
+					Pos:     stmt.Pos(),
+					End:     stmt.End(),
+					NewText: []byte(c.text(stmt.Lhs[0]) + " " + stmt.Tok.String() + " " + name + ".Else(" + zero + ", " + value + ")"),
+				}},
+			}}
+		}
+	}
+
+	c.pass.Report(diag)
+}
+
+// discarded checks Opt.Get() calls used as statements.
+func (c *checker) discarded(stmt *ast.ExprStmt) {
+	if _, ok := c.optMethod(stmt.X, "Get"); ok {
+		c.pass.ReportRangef(stmt, "results of Opt.Get are discarded")
+	}
+}
+
+// write checks the assigned expression for writes through Opt.Ptr(), stmt is nil for ++ and --.
+func (c *checker) write(lhs ast.Expr, stmt *ast.AssignStmt) {
+	recv, ok := c.ptrTarget(lhs)
+	if !ok {
+		return
+	}
+
+	diag := analysis.Diagnostic{
+		Pos:     lhs.Pos(),
+		End:     lhs.End(),
+		Message: "write through Opt.Ptr() modifies a copy of the value",
+	}
+
+	star, isStar := ast.Unparen(lhs).(*ast.StarExpr)
+	if stmt != nil && isStar && stmt.Tok == token.ASSIGN && len(stmt.Lhs) == 1 && isAddressable(recv) {
+		target, _ := c.optValue(recv)
+
+		if name, ok := analysisutil.ImportName(c.pass, c.file, analysisutil.OptPath); ok {
+			diag.SuggestedFixes = []analysis.SuggestedFix{{
+				Message: "Assign opt.Of",
+				TextEdits: []analysis.TextEdit{{
+					Pos:     star.Pos(),
+					End:     stmt.End(),
+					NewText: []byte(target + " = " + name + ".Of(" + c.text(stmt.Rhs[0]) + ")"),
+				}},
+			}}
+		}
+	}
+
+	c.pass.Report(diag)
+}
+
+// ptrTarget tells whether writing to the expression modifies the value addressed by the result of Opt.Ptr(),
+// i.e. there is no other pointer, slice or map between them. It returns the receiver of Ptr.
+func (c *checker) ptrTarget(expr ast.Expr) (ast.Expr, bool) {
+	for {
+		var base ast.Expr
+
+		switch e := ast.Unparen(expr).(type) {
+		case *ast.StarExpr:
+			base = e.X
+		case *ast.SelectorExpr:
+			if sel, ok := c.pass.TypesInfo.Selections[e]; !ok || sel.Kind() != types.FieldVal {
+				return nil, false
+			}
+
+			base = e.X
+		case *ast.IndexExpr:
+			base = e.X
+		default:
+			return nil, false
+		}
+
+		if recv, ok := c.optMethod(base, "Ptr"); ok {
+			return recv, true
+		}
+
+		switch c.pass.TypesInfo.TypeOf(base).Underlying().(type) {
+		case *types.Struct, *types.Array:
+			expr = base // the value is stored inline
+		default:
+			return nil, false
+		}
+	}
+}
+
+// compare checks == and != between Opt values.
+func (c *checker) compare(expr *ast.BinaryExpr) {
+	if expr.Op != token.EQL && expr.Op != token.NEQ {
+		return
+	}
+
+	typ := c.pass.TypesInfo.TypeOf(expr.X)
+	if !isOpt(typ) || !isOpt(c.pass.TypesInfo.TypeOf(expr.Y)) {
+		return
+	}
+
+	message := "comparison of opt.Opt values with " + expr.Op.String() + " compares values of missing options"
+	if hasReferences(analysisutil.TypeArg(typ)) {
+		message += " and pointers by address"
+	}
+
+	diag := analysis.Diagnostic{Pos: expr.Pos(), End: expr.End(), Message: message}
+
+	switch {
+	case isZeroLiteral(expr.Y) || isZeroLiteral(expr.X):
+		operand := expr.X
+		if isZeroLiteral(expr.X) {
+			operand = expr.Y
+		}
+
+		method := "IsMissing"
+		if expr.Op == token.NEQ {
+			method = "IsPresent"
+		}
+
+		diag.SuggestedFixes = []analysis.SuggestedFix{{
+			Message: "Use Opt." + method,
+			TextEdits: []analysis.TextEdit{{
+				Pos:     expr.Pos(),
+				End:     expr.End(),
+				NewText: []byte(c.text(operand) + "." + method + "()"),
+			}},
+		}}
+	default:
+		name, edits := analysisutil.RequireImport(c.pass, c.file, analysisutil.PtrPath, "ptr")
+
+		not := ""
+		if expr.Op == token.NEQ {
+			not = "!"
+		}
+
+		diag.SuggestedFixes = []analysis.SuggestedFix{{
+			Message: "Use ptr.DeepEqual",
+			TextEdits: append([]analysis.TextEdit{{
+				Pos:     expr.Pos(),
+				End:     expr.End(),
+				NewText: []byte(not + name + ".DeepEqual(" + c.text(expr.X) + ", " + c.text(expr.Y) + ")"),
+			}}, edits...),
+		}}
+	}
+
+	c.pass.Report(diag)
+}
+
+// nested checks opt.Of calls wrapping Opt values.
+func (c *checker) nested(call *ast.CallExpr) {
+	if len(call.Args) != 1 || !analysisutil.IsFunc(analysisutil.Callee(c.pass.TypesInfo, call), analysisutil.OptPath, "Of") || !isOpt(c.pass.TypesInfo.TypeOf(call.Args[0])) {
+		return
+	}
+
+	// no fix: dropping opt.Of changes the type of the expression, it rarely type-checks
+	c.pass.Report(analysis.Diagnostic{
+		Pos:     call.Pos(),
+		End:     call.End(),
+		Message: "opt.Of wraps an opt.Opt value into a nested option",
+	})
+}
+
+func isBlank(expr ast.Expr) bool {
+	ident, ok := expr.(*ast.Ident)
+
+	return ok && ident.Name == "_"
+}
+
+// isAddressable tells whether the expression is a variable or a field path of one, so it can be assigned.
+func isAddressable(expr ast.Expr) bool {
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.Ident:
+		return e.Name != "_"
+	case *ast.SelectorExpr:
+		return isAddressable(e.X)
+	case *ast.StarExpr:
+		return true
+	default:
+		return false
+	}
+}
+
+// isZeroLiteral tells whether the expression is the literal opt.Opt[T]{}.
+func isZeroLiteral(expr ast.Expr) bool {
+	lit, ok := ast.Unparen(expr).(*ast.CompositeLit)
+
+	return ok && len(lit.Elts) == 0
+}
+
+// hasReferences tells whether values of the type hold pointers compared by address.
+func hasReferences(typ types.Type) bool {
+	switch t := typ.Underlying().(type) {
+	case *types.Pointer, *types.Interface, *types.Chan:
+		return true
+	case *types.Array:
+		return hasReferences(t.Elem())
+	case *types.Struct:
+		for field := range t.Fields() {
+			if hasReferences(field.Type()) {
+				return true
+			}
+		}
+
+		return false
+	default:
+		return false
+	}
+}
+
+// zeroValue returns the literal of the zero value for basic, pointer and reference types.
+func zeroValue(typ types.Type) (string, bool) {
+	switch t := typ.Underlying().(type) {
+	case *types.Basic:
+		switch {
+		case t.Info()&types.IsBoolean != 0:
+			return "false", true
+		case t.Info()&types.IsString != 0:
+			return `""`, true
+		case t.Info()&types.IsNumeric != 0:
+			return "0", true
+		default:
+			return "", false
+		}
+	case *types.Pointer, *types.Slice, *types.Map, *types.Chan, *types.Signature, *types.Interface:
+		return "nil", true
+	default:
+		return "", false
+	}
+}
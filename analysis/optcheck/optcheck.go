@@ -0,0 +1,115 @@
+// Package optcheck defines an Analyzer reporting misuse of opt.Opt, the value-based optional type.
+//
+// The analyzer reports:
+//   - writes through Opt.Ptr(), the pointer addresses a copy of the value, so `*o.Ptr() = v` changes nothing,
+//   - Opt.Get() calls whose ok result is discarded, missing options may hold any value,
+//   - `==` and `!=` between Opt values, they compare values hidden in missing options and pointers by address,
+//   - opt.Of(x) where x is already an Opt, nesting options.
+//
+// Mechanical rewrites are offered as suggested fixes: `o = opt.Of(v)`, opt.Else, o.IsMissing()
+// and ptr.DeepEqual.
+package optcheck
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/sr9000/go-ptr-tools/analysis/internal/analysisutil"
+)
+
+// Analyzer reports misuse of opt.Opt.
+var Analyzer = &analysis.Analyzer{ //nolint:gochecknoglobals // analyzers are declared as globals
+	Name: "optcheck",
+	Doc:  "report misuse of opt.Opt values",
+	URL:  "https://pkg.go.dev/github.com/sr9000/go-ptr-tools/analysis/optcheck",
+	Run:  run,
+}
+
+// checker holds the state of a single file.
+type checker struct {
+	pass *analysis.Pass
+	file *ast.File
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	if pass.Pkg.Path() == analysisutil.OptPath {
+		return nil, nil //nolint:nilnil // no result
+	}
+
+	for _, file := range pass.Files {
+		c := &checker{pass: pass, file: file}
+
+		ast.Inspect(file, func(node ast.Node) bool {
+			switch node := node.(type) {
+			case *ast.AssignStmt:
+				c.assign(node)
+			case *ast.IncDecStmt:
+				c.write(node.X, nil)
+			case *ast.ExprStmt:
+				c.discarded(node)
+			case *ast.BinaryExpr:
+				c.compare(node)
+			case *ast.CallExpr:
+				c.nested(node)
+			default:
+			}
+
+			return true
+		})
+	}
+
+	return nil, nil //nolint:nilnil // no result
+}
+
+// isOpt tells whether typ is an instantiation of opt.Opt.
+func isOpt(typ types.Type) bool {
+	return analysisutil.IsNamed(typ, analysisutil.OptPath, "Opt")
+}
+
+// optMethod returns the receiver of the call if it calls the opt.Opt method with the name,
+// the receiver may be a pointer to opt.Opt.
+func (c *checker) optMethod(expr ast.Expr, name string) (ast.Expr, bool) {
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok {
+		return nil, false
+	}
+
+	sel, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != name {
+		return nil, false
+	}
+
+	selection, ok := c.pass.TypesInfo.Selections[sel]
+	if !ok || selection.Kind() != types.MethodVal {
+		return nil, false
+	}
+
+	recv := selection.Recv()
+	if ptr, ok := recv.Underlying().(*types.Pointer); ok {
+		recv = ptr.Elem()
+	}
+
+	if !isOpt(recv) {
+		return nil, false
+	}
+
+	return sel.X, true
+}
+
+// optValue returns the source code of the Opt value the receiver returned by optMethod denotes and its type,
+// pointers are dereferenced.
+func (c *checker) optValue(recv ast.Expr) (string, types.Type) {
+	typ := c.pass.TypesInfo.TypeOf(recv)
+	if ptr, ok := typ.Underlying().(*types.Pointer); ok {
+		return "*" + c.text(recv), ptr.Elem()
+	}
+
+	return c.text(recv), typ
+}
+
+// text returns the source code of the node.
+func (c *checker) text(node ast.Node) string {
+	return analysisutil.Source(c.pass, node)
+}
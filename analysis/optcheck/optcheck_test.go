@@ -0,0 +1,15 @@
+package optcheck_test
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/sr9000/go-ptr-tools/analysis/optcheck"
+)
+
+func TestAnalyzer(t *testing.T) {
+	t.Parallel()
+
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), optcheck.Analyzer, "example")
+}
@@ -0,0 +1,81 @@
+package example
+
+import (
+	"github.com/sr9000/go-ptr-tools/opt"
+)
+
+type Point struct {
+	X, Y  int
+	Next  *Point
+	Tags  []string
+	Inner [2]int
+}
+
+type Holder struct {
+	Value opt.Opt[int]
+	Ref   *opt.Opt[int]
+}
+
+func writes(o opt.Opt[int], p opt.Opt[Point], h *Holder) {
+	*o.Ptr() = 42               // want `write through Opt.Ptr\(\) modifies a copy of the value`
+	*(o.Ptr()) = 42             // want `write through Opt.Ptr\(\) modifies a copy of the value`
+	*h.Value.Ptr() = 42         // want `write through Opt.Ptr\(\) modifies a copy of the value`
+	*o.Ptr() += 1               // want `write through Opt.Ptr\(\) modifies a copy of the value`
+	*o.Ptr()++                  // want `write through Opt.Ptr\(\) modifies a copy of the value`
+	p.Ptr().X = 1               // want `write through Opt.Ptr\(\) modifies a copy of the value`
+	p.Ptr().Inner[0] = 1        // want `write through Opt.Ptr\(\) modifies a copy of the value`
+	p.Ptr().X, p.Ptr().Y = 1, 2 // want `write through Opt.Ptr\(\) modifies a copy of the value` `write through Opt.Ptr\(\) modifies a copy of the value`
+
+	p.Ptr().Next.X = 1      // shared pointee
+	p.Ptr().Tags[0] = "tag" // shared backing array
+	x := *o.Ptr()
+	x++
+	_ = x
+}
+
+func pointerWrites(o *opt.Opt[int], h *Holder) {
+	*o.Ptr() = 42     // want `write through Opt.Ptr\(\) modifies a copy of the value`
+	*h.Ref.Ptr() = 42 // want `write through Opt.Ptr\(\) modifies a copy of the value`
+	*o.Ptr() += 1     // want `write through Opt.Ptr\(\) modifies a copy of the value`
+
+	v, _ := o.Get() // want `ok result of Opt.Get is discarded`
+	_ = v
+}
+
+func gets(o opt.Opt[int], s opt.Opt[string], p opt.Opt[Point]) {
+	v, _ := o.Get() // want `ok result of Opt.Get is discarded, missing options may hold any value`
+	_ = v
+
+	var w string
+	w, _ = s.Get() // want `ok result of Opt.Get is discarded`
+	_ = w
+
+	pt, _ := p.Get() // want `ok result of Opt.Get is discarded`
+	_ = pt
+
+	_, _ = o.Get() // want `ok result of Opt.Get is discarded`
+	o.Get()        // want `results of Opt.Get are discarded`
+
+	if v, ok := o.Get(); ok {
+		_ = v
+	}
+
+	_, ok := o.Get()
+	_ = ok
+}
+
+func compares(a, b opt.Opt[int], c, d opt.Opt[*Point]) bool {
+	_ = a == b              // want `comparison of opt.Opt values with == compares values of missing options$`
+	_ = c != d              // want `comparison of opt.Opt values with != compares values of missing options and pointers by address`
+	_ = a == opt.Opt[int]{} // want `comparison of opt.Opt values with ==`
+	_ = opt.Opt[int]{} != b // want `comparison of opt.Opt values with !=`
+
+	return a.IsPresent() == b.IsPresent()
+}
+
+func nested(o opt.Opt[int]) {
+	_ = opt.Of(o)               // want `opt.Of wraps an opt.Opt value into a nested option`
+	_ = opt.Of[opt.Opt[int]](o) // want `opt.Of wraps an opt.Opt value into a nested option`
+	_ = opt.Of(opt.Of(1))       // want `opt.Of wraps an opt.Opt value into a nested option`
+	_ = opt.Of(1)
+}
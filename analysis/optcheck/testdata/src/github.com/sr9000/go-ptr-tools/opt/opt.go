@@ -0,0 +1,33 @@
+// Package opt is a stub of github.com/sr9000/go-ptr-tools/opt for the analyzer tests.
+package opt
+
+type Opt[T any] struct {
+	val T
+	ok  bool
+}
+
+func (o Opt[T]) IsPresent() bool { return o.ok }
+
+func (o Opt[T]) IsMissing() bool { return !o.ok }
+
+func (o Opt[T]) Get() (T, bool) { return o.val, o.ok }
+
+func (o Opt[T]) Ptr() *T {
+	if !o.ok {
+		return nil
+	}
+
+	return &o.val
+}
+
+func Of[T any](val T) Opt[T] { return Opt[T]{val: val, ok: true} }
+
+func Else[T any](final T, opts ...Opt[T]) T {
+	for _, o := range opts {
+		if val, ok := o.Get(); ok {
+			return val
+		}
+	}
+
+	return final
+}
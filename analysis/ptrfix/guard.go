@@ -0,0 +1,436 @@
+package ptrfix
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/sr9000/go-ptr-tools/analysis/internal/analysisutil"
+)
+
+// guard is the condition of an if statement: either `p != nil && q != nil ...` or `v, ok := src; ok`.
+type guard struct {
+	ptrs []ast.Expr // checked pointers
+
+	val, ok *ast.Ident // comma-ok guard variables
+	src     ast.Expr   // comma-ok expression
+}
+
+// parseGuard recognizes the guard of the if statement.
+func (f *fixer) parseGuard(stmt *ast.IfStmt) (*guard, bool) {
+	if stmt.Init == nil {
+		ptrs, ok := f.nilChecks(stmt.Cond)
+		if !ok || len(ptrs) > maxArity {
+			return nil, false
+		}
+
+		return &guard{ptrs: ptrs}, true
+	}
+
+	init, ok := stmt.Init.(*ast.AssignStmt)
+	if !ok || init.Tok != token.DEFINE || len(init.Lhs) != 2 || len(init.Rhs) != 1 {
+		return nil, false
+	}
+
+	val, valOk := init.Lhs[0].(*ast.Ident)
+	flag, flagOk := init.Lhs[1].(*ast.Ident)
+	cond, condOk := ast.Unparen(stmt.Cond).(*ast.Ident)
+
+	if !valOk || !flagOk || !condOk || val.Name == "_" || cond.Name != flag.Name {
+		return nil, false
+	}
+
+	return &guard{val: val, ok: flag, src: init.Rhs[0]}, true
+}
+
+// nilChecks collects pointers of the `p != nil && q != nil ...` condition.
+func (f *fixer) nilChecks(cond ast.Expr) ([]ast.Expr, bool) {
+	bin, ok := ast.Unparen(cond).(*ast.BinaryExpr)
+	if !ok {
+		return nil, false
+	}
+
+	switch bin.Op {
+	case token.LAND:
+		left, ok := f.nilChecks(bin.X)
+		if !ok {
+			return nil, false
+		}
+
+		right, ok := f.nilChecks(bin.Y)
+
+		return append(left, right...), ok
+	case token.NEQ:
+		operand := bin.X
+		if f.isNil(bin.X) {
+			operand = bin.Y
+		} else if !f.isNil(bin.Y) {
+			return nil, false
+		}
+
+		_, isPtr := f.pass.TypesInfo.TypeOf(operand).Underlying().(*types.Pointer)
+
+		return []ast.Expr{ast.Unparen(operand)}, isPtr && f.isVar(operand)
+	default:
+		return nil, false
+	}
+}
+
+// isCall tells whether the comma-ok guard takes the results of a function call, so it can be inlined.
+func (g *guard) isCall() bool {
+	_, ok := ast.Unparen(g.src).(*ast.CallExpr)
+
+	return ok
+}
+
+// matchCall recognizes the call taking the guarded values: `f(*p, *q)` for nil checks or `f(v)` for comma-ok.
+// It returns the function and the pointers in the order of arguments.
+func (f *fixer) matchCall(g *guard, expr ast.Expr, results int) (ast.Expr, []ast.Expr, bool) {
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok || !f.isFunc(call.Fun) || f.uses(call.Fun, g.val) || f.uses(call.Fun, g.ok) {
+		return nil, nil, false
+	}
+
+	sig, ok := f.pass.TypesInfo.TypeOf(call.Fun).(*types.Signature)
+	if !ok || sig.Variadic() || sig.Results().Len() != results || sig.Params().Len() != len(call.Args) {
+		return nil, nil, false
+	}
+
+	if g.val != nil {
+		arg, ok := ast.Unparen(call.Args[0]).(*ast.Ident)
+		matched := ok && len(call.Args) == 1 && f.pass.TypesInfo.Uses[arg] == f.pass.TypesInfo.Defs[g.val] &&
+			types.Identical(sig.Params().At(0).Type(), f.pass.TypesInfo.TypeOf(arg))
+
+		return call.Fun, nil, matched
+	}
+
+	if len(call.Args) != len(g.ptrs) {
+		return nil, nil, false
+	}
+
+	ptrs := make([]ast.Expr, 0, len(g.ptrs))
+	unused := make(map[string]bool, len(g.ptrs))
+
+	for _, ptr := range g.ptrs {
+		unused[types.ExprString(ptr)] = true
+	}
+
+	for i, arg := range call.Args {
+		star, ok := ast.Unparen(arg).(*ast.StarExpr)
+		if !ok || !unused[types.ExprString(ast.Unparen(star.X))] ||
+			!types.Identical(sig.Params().At(i).Type(), f.pass.TypesInfo.TypeOf(star)) {
+			return nil, nil, false
+		}
+
+		delete(unused, types.ExprString(ast.Unparen(star.X)))
+		ptrs = append(ptrs, ast.Unparen(star.X))
+	}
+
+	return call.Fun, ptrs, true
+}
+
+// void rewrites `if p != nil { f(*p) }` and `if v, ok := src; ok { f(v) }`.
+func (f *fixer) void(stmt *ast.IfStmt) bool {
+	g, ok := f.parseGuard(stmt)
+	if !ok || stmt.Else != nil || len(stmt.Body.List) != 1 {
+		return false
+	}
+
+	expr, ok := stmt.Body.List[0].(*ast.ExprStmt)
+	if !ok {
+		return false
+	}
+
+	fn, ptrs, ok := f.matchCall(g, expr.X, 0)
+	if !ok {
+		return false
+	}
+
+	switch {
+	case g.val == nil:
+		name := f.pkg(analysisutil.PtrPath, "ptr")
+		fun := monadName("Void", len(ptrs))
+
+		f.report([]ast.Stmt{stmt}, "nil check can be replaced with ptr."+fun,
+			call(name+"."+fun, append(f.texts(ptrs), f.text(fn))...))
+	case g.isCall():
+		name := f.pkg(analysisutil.OptPath, "opt")
+
+		f.report([]ast.Stmt{stmt}, "comma-ok check can be replaced with opt.ApplyVoid",
+			call(name+".ApplyVoid", call(name+".FromOk", f.text(g.src)), f.text(fn)))
+	case f.isFree(stmt, g.val.Name) && f.isFree(stmt, g.ok.Name):
+		name := f.pkg(analysisutil.OptPath, "opt")
+
+		f.report([]ast.Stmt{stmt}, "comma-ok check can be replaced with opt.ApplyVoid",
+			g.val.Name+", "+g.ok.Name+" := "+f.text(g.src),
+			call(name+".ApplyVoid", call(name+".FromOk", g.val.Name, g.ok.Name), f.text(fn)))
+	default:
+		return false
+	}
+
+	return true
+}
+
+// assign rewrites assignments of results to a zero target, the one declared by decl or reset in the else branch:
+//
+//	var r *R; if p != nil { r = ptr.Of(f(*p)) }
+//	if p != nil { x := f(*p); r = &x } else { r = nil }
+//	var r opt.Opt[R]; if v, ok := g(); ok { r = opt.Of(f(v)) }
+func (f *fixer) assign(stmts []ast.Stmt, stmt *ast.IfStmt, decl *ast.Ident) bool {
+	g, ok := f.parseGuard(stmt)
+	if !ok || (g.val != nil && !g.isCall()) {
+		return false
+	}
+
+	target, inner, ok := f.assignedResult(g, stmt.Body.List)
+	if !ok {
+		return false
+	}
+
+	fn, ptrs, ok := f.matchCall(g, inner, 1)
+	if !ok || !f.resultFits(g, target, fn) {
+		return false
+	}
+
+	tok := "="
+
+	switch {
+	case decl != nil:
+		ident, ok := target.(*ast.Ident)
+		if !ok || stmt.Else != nil || f.pass.TypesInfo.Uses[ident] != f.pass.TypesInfo.Defs[decl] || f.uses(fn, decl) {
+			return false
+		}
+
+		tok = ":="
+	case !f.resetsZero(g, stmt.Else, target):
+		return false
+	}
+
+	if g.val == nil {
+		name := f.pkg(analysisutil.PtrPath, "ptr")
+		fun := monadName("", len(ptrs))
+
+		f.report(stmts, "nil check can be replaced with ptr."+fun,
+			f.text(target)+" "+tok+" "+call(name+"."+fun, append(f.texts(ptrs), f.text(fn))...))
+	} else {
+		name := f.pkg(analysisutil.OptPath, "opt")
+
+		f.report(stmts, "comma-ok check can be replaced with opt.Apply",
+			f.text(target)+" "+tok+" "+call(name+".Apply", call(name+".FromOk", f.text(g.src)), f.text(fn)))
+	}
+
+	return true
+}
+
+// assignedResult recognizes `r = ptr.Of(call)`, `x := call; r = &x` and `r = opt.Of(call)` bodies.
+func (f *fixer) assignedResult(g *guard, body []ast.Stmt) (ast.Expr, ast.Expr, bool) {
+	if len(body) == 0 {
+		return nil, nil, false
+	}
+
+	last, ok := body[len(body)-1].(*ast.AssignStmt)
+	if !ok || last.Tok != token.ASSIGN || len(last.Lhs) != 1 || len(last.Rhs) != 1 || !f.isVar(last.Lhs[0]) {
+		return nil, nil, false
+	}
+
+	switch len(body) {
+	case 1:
+		wrap, ok := ast.Unparen(last.Rhs[0]).(*ast.CallExpr)
+		if !ok || len(wrap.Args) != 1 {
+			return nil, nil, false
+		}
+
+		path := analysisutil.PtrPath
+		if g.val != nil {
+			path = analysisutil.OptPath
+		}
+
+		return last.Lhs[0], wrap.Args[0], analysisutil.IsFunc(analysisutil.Callee(f.pass.TypesInfo, wrap), path, "Of")
+	case 2: //nolint:mnd // the result and the assignment
+		def, ok := body[0].(*ast.AssignStmt)
+		if !ok || g.val != nil || def.Tok != token.DEFINE || len(def.Lhs) != 1 || len(def.Rhs) != 1 {
+			return nil, nil, false
+		}
+
+		addr, ok := ast.Unparen(last.Rhs[0]).(*ast.UnaryExpr)
+		if !ok || addr.Op != token.AND {
+			return nil, nil, false
+		}
+
+		x, ok := ast.Unparen(addr.X).(*ast.Ident)
+		tmp, tmpOk := def.Lhs[0].(*ast.Ident)
+
+		return last.Lhs[0], def.Rhs[0], ok && tmpOk && f.pass.TypesInfo.Uses[x] == f.pass.TypesInfo.Defs[tmp]
+	default:
+		return nil, nil, false
+	}
+}
+
+// resultFits tells whether the target is *R for nil checks or opt.Opt[R] for comma-ok guards, R is the result of fn.
+func (f *fixer) resultFits(g *guard, target, fn ast.Expr) bool {
+	res := f.pass.TypesInfo.TypeOf(fn).(*types.Signature).Results().At(0).Type() //nolint:forcetypeassert // matched
+	typ := f.pass.TypesInfo.TypeOf(target)
+
+	if g.val != nil {
+		return analysisutil.IsNamed(typ, analysisutil.OptPath, "Opt") && types.Identical(analysisutil.TypeArg(typ), res)
+	}
+
+	ptr, ok := typ.(*types.Pointer)
+
+	return ok && types.Identical(ptr.Elem(), res)
+}
+
+// resetsZero tells whether the else branch is `r = nil` for nil checks or `r = opt.Opt[R]{}` for comma-ok guards.
+func (f *fixer) resetsZero(g *guard, els ast.Stmt, target ast.Expr) bool {
+	block, ok := els.(*ast.BlockStmt)
+	if !ok || len(block.List) != 1 {
+		return false
+	}
+
+	reset, ok := block.List[0].(*ast.AssignStmt)
+	if !ok || reset.Tok != token.ASSIGN || len(reset.Lhs) != 1 || len(reset.Rhs) != 1 ||
+		types.ExprString(reset.Lhs[0]) != types.ExprString(target) {
+		return false
+	}
+
+	if g.val == nil {
+		return f.isNil(reset.Rhs[0])
+	}
+
+	lit, ok := ast.Unparen(reset.Rhs[0]).(*ast.CompositeLit)
+
+	return ok && len(lit.Elts) == 0
+}
+
+// declaredZero recognizes `var r T` declaring a single variable without a value.
+func declaredZero(stmt ast.Stmt) (*ast.Ident, bool) {
+	decl, ok := stmt.(*ast.DeclStmt)
+	if !ok {
+		return nil, false
+	}
+
+	gen, ok := decl.Decl.(*ast.GenDecl)
+	if !ok || gen.Tok != token.VAR || len(gen.Specs) != 1 {
+		return nil, false
+	}
+
+	spec, ok := gen.Specs[0].(*ast.ValueSpec)
+	if !ok || len(spec.Names) != 1 || len(spec.Values) != 0 || spec.Names[0].Name == "_" {
+		return nil, false
+	}
+
+	return spec.Names[0], true
+}
+
+// isFree tells whether the name may be declared right before the statement:
+// it neither shadows a visible declaration nor conflicts with a later one in the same block.
+func (f *fixer) isFree(stmt *ast.IfStmt, name string) bool {
+	scope := f.pass.Pkg.Scope().Innermost(stmt.Pos())
+	if scope == f.pass.TypesInfo.Scopes[stmt] {
+		scope = scope.Parent() // the block containing the statement
+	}
+
+	if scope == nil || scope.Lookup(name) != nil {
+		return false
+	}
+
+	_, obj := scope.LookupParent(name, stmt.Pos())
+
+	return obj == nil
+}
+
+// isFunc tells whether the function expression can be passed as is: an identifier, a package function or
+// a method value of a variable.
+func (f *fixer) isFunc(fun ast.Expr) bool {
+	switch fun := ast.Unparen(fun).(type) {
+	case *ast.Ident:
+		if _, generic := f.pass.TypesInfo.Instances[fun]; generic {
+			return false
+		}
+
+		switch f.pass.TypesInfo.Uses[fun].(type) {
+		case *types.Func, *types.Var:
+			return true
+		default:
+			return false
+		}
+	case *ast.SelectorExpr:
+		if _, generic := f.pass.TypesInfo.Instances[fun.Sel]; generic {
+			return false
+		}
+
+		sel, ok := f.pass.TypesInfo.Selections[fun]
+		if !ok {
+			_, isFunc := f.pass.TypesInfo.Uses[fun.Sel].(*types.Func) // qualified identifier
+
+			return isFunc
+		}
+
+		return (sel.Kind() == types.FieldVal || sel.Kind() == types.MethodVal && !f.derefs(sel)) && f.isVar(fun.X)
+	default:
+		return false
+	}
+}
+
+// derefs tells whether the method value dereferences a pointer to copy the receiver, it panics for nil pointers.
+func (f *fixer) derefs(sel *types.Selection) bool {
+	_, ptrRecv := sel.Obj().Type().(*types.Signature).Recv().Type().(*types.Pointer) //nolint:forcetypeassert // method
+	_, ptrX := sel.Recv().Underlying().(*types.Pointer)
+
+	return ptrX && !ptrRecv
+}
+
+// isVar tells whether the expression is a variable or its field, evaluating it has no side effects.
+func (f *fixer) isVar(expr ast.Expr) bool {
+	switch expr := ast.Unparen(expr).(type) {
+	case *ast.Ident:
+		_, ok := f.pass.TypesInfo.Uses[expr].(*types.Var)
+
+		return ok
+	case *ast.SelectorExpr:
+		sel, ok := f.pass.TypesInfo.Selections[expr]
+
+		return ok && sel.Kind() == types.FieldVal && f.isVar(expr.X)
+	default:
+		return false
+	}
+}
+
+func (f *fixer) isNil(expr ast.Expr) bool {
+	return f.pass.TypesInfo.Types[expr].IsNil()
+}
+
+// uses tells whether the expression refers to the variable declared by the identifier.
+func (f *fixer) uses(expr ast.Expr, decl *ast.Ident) bool {
+	if decl == nil {
+		return false
+	}
+
+	obj := f.pass.TypesInfo.Defs[decl]
+	used := false
+
+	ast.Inspect(expr, func(node ast.Node) bool {
+		if ident, ok := node.(*ast.Ident); ok && f.pass.TypesInfo.Uses[ident] == obj {
+			used = true
+		}
+
+		return !used
+	})
+
+	return used
+}
+
+// monadName returns the name of the generated function taking n pointers, e.g. ApplyVoid, Apply2Void or Apply3.
+func monadName(suffix string, n int) string {
+	if n == 1 {
+		return "Apply" + suffix
+	}
+
+	return fmt.Sprintf("Apply%d%s", n, suffix)
+}
+
+func call(fun string, args ...string) string {
+	return fun + "(" + strings.Join(args, ", ") + ")"
+}
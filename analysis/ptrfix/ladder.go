@@ -0,0 +1,164 @@
+package ptrfix
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/sr9000/go-ptr-tools/analysis/internal/analysisutil"
+)
+
+// assignLadder rewrites `if a != nil { r = a } else if b != nil { r = b } else { r = c }` with ptr.Coalesce.
+// Without the final else the target keeps its value, so it becomes the last argument.
+// The target must have the type of the pointers.
+func (f *fixer) assignLadder(stmt *ast.IfStmt) bool {
+	var (
+		target ast.Expr
+		args   []ast.Expr
+	)
+
+	for cur := ast.Stmt(stmt); ; {
+		switch s := cur.(type) {
+		case *ast.IfStmt:
+			ptr, ok := f.singleCheck(s)
+			if !ok || len(s.Body.List) != 1 {
+				return false
+			}
+
+			lhs, rhs, ok := f.assignment(s.Body.List[0], target)
+			if !ok || types.ExprString(ptr) != types.ExprString(rhs) || !f.sameType(ptr, lhs) {
+				return false // an interface target would get a typed nil from ptr.Coalesce
+			}
+
+			target, args = lhs, append(args, ptr)
+
+			if s.Else != nil {
+				cur = s.Else
+
+				continue
+			}
+
+			if len(args) == 1 || !f.fallback(target, args[0]) {
+				return false // a single check keeping the value is clear enough
+			}
+
+			args = append(args, target)
+		case *ast.BlockStmt:
+			if len(s.List) != 1 {
+				return false
+			}
+
+			_, rhs, ok := f.assignment(s.List[0], target)
+			if !ok || !f.fallback(rhs, args[0]) || len(args) == 1 && f.isNil(rhs) {
+				return false
+			}
+
+			args = f.appendFallback(args, rhs)
+		default:
+			return false
+		}
+
+		break
+	}
+
+	name := f.pkg(analysisutil.PtrPath, "ptr")
+
+	f.report([]ast.Stmt{stmt}, "if-else ladder can be replaced with ptr.Coalesce",
+		f.text(target)+" = "+call(name+".Coalesce", f.texts(args)...))
+
+	return true
+}
+
+// returnLadder rewrites `if a != nil { return a }; if b != nil { return b }; return c` with ptr.Coalesce.
+// The function must return the type of the pointers.
+func (f *fixer) returnLadder(list []ast.Stmt) int {
+	var args []ast.Expr
+
+	for i, stmt := range list {
+		switch s := stmt.(type) {
+		case *ast.IfStmt:
+			ptr, ok := f.singleCheck(s)
+			if !ok || s.Else != nil || len(s.Body.List) != 1 {
+				return 0
+			}
+
+			ret, ok := s.Body.List[0].(*ast.ReturnStmt)
+			if !ok || len(ret.Results) != 1 || types.ExprString(ast.Unparen(ret.Results[0])) != types.ExprString(ptr) ||
+				!f.returns(ptr) {
+				return 0
+			}
+
+			args = append(args, ptr)
+		case *ast.ReturnStmt:
+			if len(args) == 0 || len(s.Results) != 1 || !f.fallback(s.Results[0], args[0]) ||
+				len(args) == 1 && f.isNil(s.Results[0]) {
+				return 0
+			}
+
+			name := f.pkg(analysisutil.PtrPath, "ptr")
+
+			f.report(list[:i+1], "return ladder can be replaced with ptr.Coalesce",
+				"return "+call(name+".Coalesce", f.texts(f.appendFallback(args, s.Results[0]))...))
+
+			return i + 1
+		default:
+			return 0
+		}
+	}
+
+	return 0
+}
+
+// singleCheck recognizes the `p != nil` condition of the if statement without initialization.
+func (f *fixer) singleCheck(stmt *ast.IfStmt) (ast.Expr, bool) {
+	if stmt.Init != nil {
+		return nil, false
+	}
+
+	ptrs, ok := f.nilChecks(stmt.Cond)
+	if !ok || len(ptrs) != 1 {
+		return nil, false
+	}
+
+	return ptrs[0], true
+}
+
+// assignment recognizes `target = value` assigning to a variable, the same as target unless it is nil.
+func (f *fixer) assignment(stmt ast.Stmt, target ast.Expr) (ast.Expr, ast.Expr, bool) {
+	assign, ok := stmt.(*ast.AssignStmt)
+	if !ok || assign.Tok != token.ASSIGN || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 || !f.isVar(assign.Lhs[0]) {
+		return nil, nil, false
+	}
+
+	if target != nil && types.ExprString(target) != types.ExprString(assign.Lhs[0]) {
+		return nil, nil, false
+	}
+
+	return assign.Lhs[0], ast.Unparen(assign.Rhs[0]), true
+}
+
+// fallback tells whether the expression can be the last argument of ptr.Coalesce along with the pointer:
+// nil or a variable of the same type, evaluating it earlier has no side effects.
+func (f *fixer) fallback(expr, ptr ast.Expr) bool {
+	return f.isNil(expr) || f.isVar(expr) && f.sameType(expr, ptr)
+}
+
+// appendFallback appends the last argument of ptr.Coalesce, nil is omitted as Coalesce returns it anyway.
+func (f *fixer) appendFallback(args []ast.Expr, expr ast.Expr) []ast.Expr {
+	if f.isNil(expr) {
+		return args
+	}
+
+	return append(args, expr)
+}
+
+// returns tells whether the function returns exactly the type of the expression,
+// an interface result would get a typed nil from ptr.Coalesce.
+func (f *fixer) returns(expr ast.Expr) bool {
+	return f.sig != nil && f.sig.Results().Len() == 1 &&
+		types.Identical(f.sig.Results().At(0).Type(), f.pass.TypesInfo.TypeOf(expr))
+}
+
+func (f *fixer) sameType(a, b ast.Expr) bool {
+	return types.Identical(f.pass.TypesInfo.TypeOf(a), f.pass.TypesInfo.TypeOf(b))
+}
@@ -0,0 +1,175 @@
+// Package ptrfix defines an Analyzer suggesting to rewrite manual nil checks with functions of the ptr and opt packages.
+//
+// Rewrites keep the behavior, so only exact shapes are recognized:
+//
+//	if p != nil && q != nil { f(*p, *q) }                 // ptr.Apply2Void(p, q, f)
+//	if v, ok := lookup(k); ok { f(v) }                    // opt.ApplyVoid(opt.FromOk(lookup(k)), f)
+//	if v, ok := m[k]; ok { f(v) }                         // v, ok := m[k]; opt.ApplyVoid(opt.FromOk(v, ok), f)
+//	var r *R; if p != nil { r = ptr.Of(f(*p)) }           // r := ptr.Apply(p, f)
+//	if p != nil { x := f(*p); r = &x } else { r = nil }   // r = ptr.Apply(p, f)
+//	var r opt.Opt[R]; if v, ok := g(); ok { r = opt.Of(f(v)) } // r := opt.Apply(opt.FromOk(g()), f)
+//	if a != nil { r = a } else if b != nil { r = b } else { r = c } // r = ptr.Coalesce(a, b, c)
+//	if a != nil { return a }; if b != nil { return b }; return c   // return ptr.Coalesce(a, b, c)
+//
+// Up to 9 pointers are supported, matching ptr.Apply2..Apply9. Functions must be passed as is: identifiers,
+// package functions or method values whose parameters match the pointees exactly. Pointers must be variables
+// or fields. Comments inside rewritten statements are kept above the result.
+package ptrfix
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/sr9000/go-ptr-tools/analysis/internal/analysisutil"
+)
+
+// maxArity is the number of arguments of the largest generated monad function.
+const maxArity = 9
+
+// Analyzer suggests rewriting manual nil checks with ptr and opt functions.
+var Analyzer = &analysis.Analyzer{ //nolint:gochecknoglobals // analyzers are declared as globals
+	Name: "ptrfix",
+	Doc:  "suggest rewriting manual nil checks with ptr.Apply, opt.Apply and ptr.Coalesce",
+	URL:  "https://pkg.go.dev/github.com/sr9000/go-ptr-tools/analysis/ptrfix",
+	Run:  run,
+}
+
+// fixer holds the state of a single file.
+type fixer struct {
+	pass *analysis.Pass
+	file *ast.File
+	sig  *types.Signature // signature of the innermost function, nil outside functions
+
+	imports []analysis.TextEdit // edits adding imports required by the current rewrite
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	for _, file := range pass.Files {
+		f := &fixer{pass: pass, file: file}
+		ast.Inspect(file, f.visit)
+	}
+
+	return nil, nil //nolint:nilnil // no result
+}
+
+// visit rewrites statement lists of the node, tracking the signature of the innermost function.
+func (f *fixer) visit(node ast.Node) bool {
+	switch node := node.(type) {
+	case *ast.FuncDecl:
+		if node.Body != nil {
+			f.inside(f.pass.TypesInfo.Defs[node.Name].Type(), node.Body)
+		}
+
+		return false
+	case *ast.FuncLit:
+		f.inside(f.pass.TypesInfo.TypeOf(node), node.Body)
+
+		return false
+	case *ast.BlockStmt:
+		f.stmts(node.List)
+	case *ast.CaseClause:
+		f.stmts(node.Body)
+	case *ast.CommClause:
+		f.stmts(node.Body)
+	default:
+	}
+
+	return true
+}
+
+// inside visits the body of the function with the signature sig.
+func (f *fixer) inside(sig types.Type, body *ast.BlockStmt) {
+	outer := f.sig
+	f.sig, _ = sig.(*types.Signature)
+	ast.Inspect(body, f.visit)
+	f.sig = outer
+}
+
+// stmts rewrites shapes found in the statement list, each statement is rewritten once at most.
+func (f *fixer) stmts(list []ast.Stmt) {
+	for i := 0; i < len(list); {
+		i += max(1, f.rewrite(list[i:]))
+	}
+}
+
+// rewrite reports the shape starting at the first statement, it returns the number of rewritten statements.
+func (f *fixer) rewrite(list []ast.Stmt) int {
+	if n := f.returnLadder(list); n > 0 {
+		return n
+	}
+
+	if decl, ok := declaredZero(list[0]); ok && len(list) > 1 {
+		if stmt, ok := list[1].(*ast.IfStmt); ok && f.assign(list[:2], stmt, decl) {
+			return 2 //nolint:mnd // the declaration and the if statement
+		}
+	}
+
+	stmt, ok := list[0].(*ast.IfStmt)
+	if !ok {
+		return 0
+	}
+
+	if f.assignLadder(stmt) || f.void(stmt) || f.assign(list[:1], stmt, nil) {
+		return 1
+	}
+
+	return 0
+}
+
+// pkg returns the name of the imported package, remembering the import edit if needed.
+func (f *fixer) pkg(path, name string) string {
+	imported, edits := analysisutil.RequireImport(f.pass, f.file, path, name)
+	f.imports = append(f.imports, edits...)
+
+	return imported
+}
+
+// report suggests replacing the statements with the lines of code, comments inside the statements are kept.
+func (f *fixer) report(stmts []ast.Stmt, message string, lines ...string) {
+	start, end := stmts[0].Pos(), stmts[len(stmts)-1].End()
+	indent := "\n" + analysisutil.Indent(f.pass, stmts[0])
+
+	var kept []string
+
+	for _, group := range f.file.Comments {
+		if group.Pos() >= start && group.End() <= end {
+			for _, comment := range group.List {
+				kept = append(kept, comment.Text)
+			}
+		}
+	}
+
+	f.pass.Report(analysis.Diagnostic{
+		Pos:     start,
+		End:     end,
+		Message: message,
+		SuggestedFixes: []analysis.SuggestedFix{{
+			Message: "Rewrite",
+			TextEdits: append([]analysis.TextEdit{{
+				Pos:     start,
+				End:     end,
+				NewText: []byte(strings.Join(append(kept, lines...), indent)),
+			}}, f.imports...),
+		}},
+	})
+
+	f.imports = nil
+}
+
+// text returns the source code of the node.
+func (f *fixer) text(node ast.Node) string {
+	return analysisutil.Source(f.pass, node)
+}
+
+// texts returns the source code of the nodes.
+func (f *fixer) texts(exprs []ast.Expr) []string {
+	res := make([]string, len(exprs))
+	for i, expr := range exprs {
+		res[i] = f.text(expr)
+	}
+
+	return res
+}
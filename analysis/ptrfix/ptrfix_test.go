@@ -0,0 +1,15 @@
+package ptrfix_test
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/sr9000/go-ptr-tools/analysis/ptrfix"
+)
+
+func TestAnalyzer(t *testing.T) {
+	t.Parallel()
+
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), ptrfix.Analyzer, "example")
+}
@@ -0,0 +1,277 @@
+package example
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/sr9000/go-ptr-tools/opt"
+	"github.com/sr9000/go-ptr-tools/ptr"
+)
+
+type Service struct {
+	Name *string
+	Port *int
+	log  func(string)
+}
+
+func (s Service) Print(name string) { fmt.Println(name) }
+
+func (s *Service) Reset(port int) {}
+
+func show(n int)                    {}
+func pair(n int, s string)          {}
+func triple(a, b int, s string)     {}
+func format(n int) string           { return strconv.Itoa(n) }
+func join(n int, s string) string   { return s + strconv.Itoa(n) }
+func lookup(key string) (int, bool) { return 0, false }
+func many(n ...int)                 {}
+func anything(v any)                {}
+
+func void(p, q *int, s *string, svc Service, psvc *Service) {
+	if p != nil { // want `nil check can be replaced with ptr.ApplyVoid`
+		// show the value
+		show(*p)
+	}
+
+	if p != nil && s != nil { // want `nil check can be replaced with ptr.Apply2Void`
+		pair(*p, *s)
+	}
+
+	if s != nil && (q != nil && p != nil) { // want `nil check can be replaced with ptr.Apply3Void`
+		triple(*q, *p, *s)
+	}
+
+	if nil != svc.Name { // want `nil check can be replaced with ptr.ApplyVoid`
+		svc.Print(*svc.Name)
+	}
+
+	if svc.Name != nil { // want `nil check can be replaced with ptr.ApplyVoid`
+		svc.log(*svc.Name)
+	}
+
+	if p != nil { // want `nil check can be replaced with ptr.ApplyVoid`
+		psvc.Reset(*p)
+	}
+
+	if p != nil {
+		show(*p + 1) // not the pointee
+	}
+
+	if p != nil {
+		show(*q) // another pointer
+	}
+
+	if p != nil && q != nil {
+		show(*p) // q is not used
+	}
+
+	if p != nil {
+		many(*p) // variadic
+	}
+
+	if p != nil {
+		anything(*p) // conversion to any
+	}
+
+	if p != nil {
+		fmt.Println(*p) // results are discarded
+	}
+
+	if p != nil {
+		show(*p)
+	} else {
+		show(0)
+	}
+}
+
+func commaOk(m map[string]int, key string, x any) {
+	if v, ok := lookup(key); ok { // want `comma-ok check can be replaced with opt.ApplyVoid`
+		show(v)
+	}
+
+	if v, ok := m[key]; ok { // want `comma-ok check can be replaced with opt.ApplyVoid`
+		show(v)
+	}
+
+	if n, isInt := x.(int); isInt { // want `comma-ok check can be replaced with opt.ApplyVoid`
+		show(n)
+	}
+
+	if v, ok := m[key]; !ok {
+		show(v)
+	}
+
+	if v, ok := m[key]; ok {
+		show(v + 1)
+	}
+}
+
+func commaOkShadow(m map[string]int, key string) {
+	v := 1
+
+	if v, ok := m[key]; ok { // v would redeclare the outer one
+		show(v)
+	}
+
+	show(v)
+
+	if w, ok := m[key]; ok { // ok is used later
+		show(w)
+	}
+
+	ok := true
+	_ = ok
+}
+
+func results(p, q *int, s *string) (*string, *string, opt.Opt[string]) {
+	var a *string // want `nil check can be replaced with ptr.Apply`
+	if p != nil {
+		a = ptr.Of(format(*p))
+	}
+
+	var b *string // want `nil check can be replaced with ptr.Apply2`
+	// the value is joined
+	if p != nil && s != nil {
+		x := join(*p, *s)
+		b = &x
+	}
+
+	if q != nil { // want `nil check can be replaced with ptr.Apply`
+		x := format(*q)
+		b = &x
+	} else {
+		b = nil
+	}
+
+	var c opt.Opt[string] // want `comma-ok check can be replaced with opt.Apply`
+	if v, ok := lookup("key"); ok {
+		c = opt.Of(format(v))
+	}
+
+	var d *string
+	show(0)
+	if p != nil { // not right after the declaration
+		d = ptr.Of(format(*p))
+	}
+
+	if p != nil { // b is not reset
+		b = ptr.Of(format(*p))
+	}
+
+	return a, ptr.Coalesce(b, d), c
+}
+
+func ladders(a, b, c *int, r *int) *int {
+	if a != nil { // want `if-else ladder can be replaced with ptr.Coalesce`
+		r = a
+	} else if b != nil {
+		r = b
+	} else {
+		r = c
+	}
+
+	if a != nil { // want `if-else ladder can be replaced with ptr.Coalesce`
+		r = a
+	} else if b != nil {
+		r = b
+	}
+
+	if a != nil { // want `if-else ladder can be replaced with ptr.Coalesce`
+		r = a
+	} else if b != nil {
+		r = b
+	} else {
+		r = nil
+	}
+
+	if a != nil {
+		r = a
+	} else {
+		r = nil
+	}
+
+	if a != nil {
+		r = a
+	}
+
+	if a != nil {
+		r = b
+	} else {
+		r = c
+	}
+
+	show(*r)
+
+	if a != nil { // want `return ladder can be replaced with ptr.Coalesce`
+		return a
+	}
+	// fall back to b
+	if b != nil {
+		return b
+	}
+
+	return nil
+}
+
+func returnLadder(a, b *int) *int {
+	if a != nil { // want `return ladder can be replaced with ptr.Coalesce`
+		return a
+	}
+
+	return b
+}
+
+func notReturnLadder(a *int, s *string) *int {
+	if a != nil {
+		return a
+	}
+
+	return new(int)
+}
+
+func singleNil(a *int) *int {
+	if a != nil {
+		return a
+	}
+
+	return nil
+}
+
+type Failure struct{}
+
+func (*Failure) Error() string { return "failure" }
+
+func notInterfaceLadder(a, b *Failure) error {
+	var err error
+	if a != nil {
+		err = a
+	} else if b != nil {
+		err = b
+	} else {
+		err = nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if a != nil {
+		return a
+	}
+
+	if b != nil {
+		return b
+	}
+
+	return nil
+}
+
+func literalLadder(a, b *int) func() *int {
+	return func() *int {
+		if a != nil { // want `return ladder can be replaced with ptr.Coalesce`
+			return a
+		}
+
+		return b
+	}
+}
@@ -0,0 +1,15 @@
+// Package opt is a stub of github.com/sr9000/go-ptr-tools/opt for the analyzer tests.
+package opt
+
+type Opt[T any] struct {
+	val T
+	ok  bool
+}
+
+func Of[T any](val T) Opt[T] { return Opt[T]{val: val, ok: true} }
+
+func FromOk[T any](val T, ok bool) Opt[T] { return Opt[T]{val: val, ok: ok} }
+
+func ApplyVoid[T1 any](t1 Opt[T1], fn func(t1 T1)) {}
+
+func Apply[R1, T1 any](t1 Opt[T1], fn func(t1 T1) (r1 R1)) (r1 Opt[R1]) { return }
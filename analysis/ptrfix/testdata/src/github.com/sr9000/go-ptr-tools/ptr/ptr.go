@@ -0,0 +1,24 @@
+// Package ptr is a stub of github.com/sr9000/go-ptr-tools/ptr for the analyzer tests.
+package ptr
+
+func Of[T any](v T) *T { return &v }
+
+func Coalesce[T any](pointers ...*T) *T {
+	for _, ptr := range pointers {
+		if ptr != nil {
+			return ptr
+		}
+	}
+
+	return nil
+}
+
+func ApplyVoid[T1 any](t1 *T1, fn func(t1 T1)) {}
+
+func Apply2Void[T1, T2 any](t1 *T1, t2 *T2, fn func(t1 T1, t2 T2)) {}
+
+func Apply3Void[T1, T2, T3 any](t1 *T1, t2 *T2, t3 *T3, fn func(t1 T1, t2 T2, t3 T3)) {}
+
+func Apply[R1, T1 any](t1 *T1, fn func(t1 T1) (r1 R1)) (r1 *R1) { return nil }
+
+func Apply2[R1, T1, T2 any](t1 *T1, t2 *T2, fn func(t1 T1, t2 T2) (r1 R1)) (r1 *R1) { return nil }
@@ -0,0 +1,189 @@
+package ptrnilness
+
+import (
+	"fmt"
+	"go/types"
+	"strings"
+
+	"github.com/sr9000/go-ptr-tools/analysis/internal/analysisutil"
+)
+
+// Nilness is the knowledge about a result of a function.
+type Nilness uint8
+
+const (
+	Unknown    Nilness = iota // nothing is known, the result is trusted
+	NeverNil                  // the pointer result is never nil
+	MaybeNil                  // the pointer result is nil unless the condition holds
+	ErrorIfNil                // the error result is not nil exactly when the Args[0] pointer is nil
+)
+
+// Cond is the condition making a MaybeNil result not nil.
+type Cond uint8
+
+const (
+	Unconditional Cond = iota // the result may always be nil
+	ArgsNotNil                // the Args pointers are not nil
+	ArgTrue                   // the Args[0] bool is true
+	ArgNil                    // the Args[0] error is nil
+	ArgPresent                // the Args[0] option is present
+)
+
+// Result describes a result of a function, arguments are indexed from the receiver of methods.
+type Result struct {
+	Nilness Nilness
+	Cond    Cond
+	Args    []int
+}
+
+// Contract is the fact describing the results of a function.
+type Contract struct {
+	Results  []Result
+	NotNil   []int     // pointer arguments the function requires not to be nil, e.g. ref.Guaranteed
+	Returned *Contract // contract of the function returned as the first result, e.g. by ptr.Monad
+}
+
+// AFact marks Contract as a fact.
+func (*Contract) AFact() {}
+
+func (c *Contract) String() string {
+	var parts []string
+
+	for _, res := range c.Results {
+		parts = append(parts, res.String())
+	}
+
+	if len(c.NotNil) > 0 {
+		parts = append(parts, fmt.Sprintf("requires %v not nil", c.NotNil))
+	}
+
+	if c.Returned != nil {
+		parts = append(parts, "returns func("+c.Returned.String()+")")
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+func (r Result) String() string {
+	switch r.Nilness {
+	case NeverNil:
+		return "never nil"
+	case ErrorIfNil:
+		return fmt.Sprintf("error if %v is nil", r.Args)
+	case MaybeNil:
+		switch r.Cond {
+		case ArgsNotNil:
+			return fmt.Sprintf("nil unless %v not nil", r.Args)
+		case ArgTrue:
+			return fmt.Sprintf("nil unless %v is true", r.Args)
+		case ArgNil:
+			return fmt.Sprintf("nil unless %v is nil", r.Args)
+		case ArgPresent:
+			return fmt.Sprintf("nil unless %v is present", r.Args)
+		default:
+			return "maybe nil"
+		}
+	default:
+		return "-"
+	}
+}
+
+// known tells whether the contract says anything.
+func (c *Contract) known() bool {
+	for _, res := range c.Results {
+		if res.Nilness != Unknown {
+			return true
+		}
+	}
+
+	return len(c.NotNil) > 0 || c.Returned != nil
+}
+
+// libraryContracts returns the contracts of the functions of the ptr, ref and opt packages.
+func libraryContracts(pkg *types.Package) map[*types.Func]*Contract {
+	res := make(map[*types.Func]*Contract)
+	scope := pkg.Scope()
+
+	switch pkg.Path() {
+	case analysisutil.PtrPath:
+		for _, name := range scope.Names() {
+			fn, ok := scope.Lookup(name).(*types.Func)
+			if !ok {
+				continue
+			}
+
+			sig := fn.Signature()
+
+			switch {
+			case name == "Of":
+				res[fn] = &Contract{Results: []Result{{Nilness: NeverNil}}}
+			case name == "FromOk":
+				res[fn] = &Contract{Results: []Result{{Nilness: MaybeNil, Cond: ArgTrue, Args: []int{1}}}}
+			case name == "FromErr":
+				res[fn] = &Contract{Results: []Result{{Nilness: MaybeNil, Cond: ArgNil, Args: []int{1}}}}
+			case name == "FromZero", name == "Coalesce", strings.HasPrefix(name, "Chain"):
+				res[fn] = &Contract{Results: []Result{{Nilness: MaybeNil}}}
+			case strings.Contains(name, "Void"):
+			case strings.HasPrefix(name, "Apply"):
+				res[fn] = applyContract(sig)
+			case strings.HasPrefix(name, "Monad") && sig.Results().Len() == 1:
+				if returned, ok := sig.Results().At(0).Type().Underlying().(*types.Signature); ok {
+					res[fn] = &Contract{Returned: applyContract(returned)}
+				}
+			default:
+			}
+		}
+	case analysisutil.RefPath:
+		if fn, ok := scope.Lookup("Guaranteed").(*types.Func); ok {
+			res[fn] = &Contract{NotNil: []int{0}}
+		}
+
+		if fn, ok := scope.Lookup("FromPtr").(*types.Func); ok {
+			res[fn] = &Contract{Results: []Result{{}, {Nilness: ErrorIfNil, Args: []int{0}}}}
+		}
+
+		if fn := method(scope, "Ref", "Ptr"); fn != nil {
+			res[fn] = &Contract{Results: []Result{{Nilness: NeverNil}}}
+		}
+	case analysisutil.OptPath:
+		if fn := method(scope, "Opt", "Ptr"); fn != nil {
+			res[fn] = &Contract{Results: []Result{{Nilness: MaybeNil, Cond: ArgPresent, Args: []int{0}}}}
+		}
+	default:
+	}
+
+	return res
+}
+
+// applyContract returns the contract of a ptr.Apply function: pointer results are nil unless all pointers are not nil.
+func applyContract(sig *types.Signature) *Contract {
+	var args []int
+
+	for i := range sig.Params().Len() {
+		if _, ok := sig.Params().At(i).Type().(*types.Pointer); ok {
+			args = append(args, i)
+		}
+	}
+
+	results := make([]Result, sig.Results().Len())
+	for i := range results {
+		if isPointer(sig.Results().At(i).Type()) {
+			results[i] = Result{Nilness: MaybeNil, Cond: ArgsNotNil, Args: args}
+		}
+	}
+
+	return &Contract{Results: results}
+}
+
+// method returns the method of the named type declared in the scope, or nil.
+func method(scope *types.Scope, typ, name string) *types.Func {
+	obj, ok := scope.Lookup(typ).(*types.TypeName)
+	if !ok {
+		return nil
+	}
+
+	fn, _, _ := types.LookupFieldOrMethod(obj.Type(), false, obj.Pkg(), name)
+	res, _ := fn.(*types.Func)
+
+	return res
+}
@@ -0,0 +1,107 @@
+package ptrnilness
+
+import (
+	"go/types"
+	"slices"
+
+	"golang.org/x/tools/go/ssa"
+)
+
+// infer computes the contract of the function from the values it returns, or nil if nothing is known.
+func (s *state) infer(fn *ssa.Function) *Contract {
+	var returns []*ssa.Return
+
+	for _, block := range fn.Blocks {
+		if ret, ok := block.Instrs[len(block.Instrs)-1].(*ssa.Return); ok {
+			returns = append(returns, ret)
+		}
+	}
+
+	results := fn.Signature.Results()
+	if results.Len() == 0 || len(returns) == 0 {
+		return nil
+	}
+
+	contract := &Contract{Results: make([]Result, results.Len())}
+
+	for i := range results.Len() {
+		switch typ := results.At(i).Type(); {
+		case isPointer(typ):
+			contract.Results[i] = s.inferPointer(fn, returns, i)
+		case len(returns) == 1 && types.Identical(typ, types.Universe.Lookup("error").Type()):
+			if res, call, _ := s.result(returns[0].Results[i]); res.Nilness == ErrorIfNil {
+				if args, ok := params(fn, call, res.Args); ok {
+					contract.Results[i] = Result{Nilness: ErrorIfNil, Args: args}
+				}
+			}
+		default:
+		}
+	}
+
+	if !contract.known() {
+		return nil
+	}
+
+	return contract
+}
+
+// inferPointer computes the nilness of the pointer result, nil constants are trusted just like unknown pointers.
+// The condition is kept when the function returns a single call with the condition on its parameters.
+func (s *state) inferPointer(fn *ssa.Function, returns []*ssa.Return, index int) Result {
+	never := true
+
+	for _, ret := range returns {
+		switch res, _ := s.nilnessOf(ret.Results[index], ret, make(map[*ssa.Phi]bool)); res {
+		case possiblyNil:
+			if len(returns) > 1 {
+				return Result{Nilness: MaybeNil}
+			}
+
+			res, call, _ := s.result(ret.Results[index])
+			if args, ok := params(fn, call, res.Args); ok && res.Nilness == MaybeNil {
+				return Result{Nilness: MaybeNil, Cond: res.Cond, Args: args}
+			}
+
+			return Result{Nilness: MaybeNil}
+		case notNil:
+		default:
+			never = false
+		}
+	}
+
+	if never {
+		return Result{Nilness: NeverNil}
+	}
+
+	return Result{}
+}
+
+// params maps the arguments of the call to the parameters of the function passing them as is.
+func params(fn *ssa.Function, call *ssa.CallCommon, args []int) ([]int, bool) {
+	if call == nil {
+		return nil, false
+	}
+
+	if len(args) == 0 {
+		return nil, true
+	}
+
+	res := make([]int, 0, len(args))
+
+	for _, arg := range args {
+		param, ok := call.Args[arg].(*ssa.Parameter)
+		if !ok {
+			return nil, false
+		}
+
+		res = append(res, slices.Index(fn.Params, param))
+	}
+
+	return res, true
+}
+
+func isPointer(typ types.Type) bool {
+	_, ok := typ.Underlying().(*types.Pointer)
+
+	return ok
+}
@@ -0,0 +1,349 @@
+package ptrnilness
+
+import (
+	"go/token"
+	"go/types"
+	"slices"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ssa"
+
+	"github.com/sr9000/go-ptr-tools/analysis/internal/analysisutil"
+)
+
+// nilness is the knowledge about a pointer value at some instruction.
+type nilness int
+
+const (
+	unknownNil nilness = iota // trusted, e.g. a parameter
+	notNil
+	possiblyNil
+	definitelyNil
+)
+
+// state holds the contracts of the package functions while they are inferred.
+type state struct {
+	pass  *analysis.Pass
+	local map[*types.Func]*Contract
+}
+
+// contract returns the contract of the function, or nil.
+func (s *state) contract(fn *types.Func) *Contract {
+	fn = fn.Origin()
+	if fn.Pkg() == s.pass.Pkg {
+		return s.local[fn]
+	}
+
+	var contract Contract
+	if s.pass.ImportObjectFact(fn, &contract) {
+		return &contract
+	}
+
+	return nil
+}
+
+// callContract returns the contract of the called function and its name for messages, or nil.
+func (s *state) callContract(call *ssa.CallCommon) (*Contract, string) {
+	if call.IsInvoke() {
+		return nil, ""
+	}
+
+	if callee := call.StaticCallee(); callee != nil {
+		if fn, ok := callee.Object().(*types.Func); ok {
+			return s.contract(fn), funcName(fn)
+		}
+
+		return nil, ""
+	}
+
+	// functions returned by ptr.Monad and alike
+	if inner, ok := call.Value.(*ssa.Call); ok {
+		if contract, name := s.callContract(&inner.Call); contract != nil && contract.Returned != nil {
+			return contract.Returned, "function returned by " + name
+		}
+	}
+
+	return nil, ""
+}
+
+// result returns the contract result producing the value along with the call and the callee name.
+func (s *state) result(val ssa.Value) (Result, *ssa.CallCommon, string) {
+	index := 0
+	if extract, ok := val.(*ssa.Extract); ok {
+		val, index = extract.Tuple, extract.Index
+	}
+
+	call, ok := val.(*ssa.Call)
+	if !ok {
+		return Result{}, nil, ""
+	}
+
+	contract, name := s.callContract(&call.Call)
+	if contract == nil || index >= len(contract.Results) {
+		return Result{}, nil, ""
+	}
+
+	return contract.Results[index], &call.Call, name
+}
+
+// nilnessOf tells whether the pointer used by the instruction may be nil, along with the callee name it comes from.
+// Only contract results are possibly nil, pointers of other origins are trusted.
+func (s *state) nilnessOf(val ssa.Value, at ssa.Instruction, visited map[*ssa.Phi]bool) (nilness, string) {
+	if res := s.checked(val, at); res != unknownNil {
+		return res, ""
+	}
+
+	switch val := val.(type) {
+	case *ssa.Const:
+		if val.IsNil() {
+			return definitelyNil, ""
+		}
+	case *ssa.ChangeType:
+		return s.nilnessOf(val.X, at, visited)
+	case *ssa.Alloc, *ssa.FieldAddr, *ssa.IndexAddr:
+		return notNil, ""
+	case *ssa.Phi:
+		return s.phiNilness(val, visited)
+	case *ssa.Call, *ssa.Extract:
+		res, call, name := s.result(val)
+
+		switch res.Nilness {
+		case NeverNil:
+			return notNil, ""
+		case MaybeNil:
+			if s.holds(res, call, at, visited) {
+				return notNil, ""
+			}
+
+			return possiblyNil, name
+		default:
+		}
+	default:
+	}
+
+	return unknownNil, ""
+}
+
+// phiNilness merges the nilness of phi edges, each edge is considered at the end of its predecessor block.
+func (s *state) phiNilness(phi *ssa.Phi, visited map[*ssa.Phi]bool) (nilness, string) {
+	if visited[phi] {
+		return unknownNil, ""
+	}
+
+	visited[phi] = true
+
+	notNils := 0
+
+	for i, edge := range phi.Edges {
+		pred := phi.Block().Preds[i]
+
+		switch res, name := s.nilnessOf(edge, pred.Instrs[len(pred.Instrs)-1], visited); res {
+		case possiblyNil:
+			return possiblyNil, name
+		case notNil:
+			notNils++
+		default:
+		}
+	}
+
+	if notNils == len(phi.Edges) {
+		return notNil, ""
+	}
+
+	return unknownNil, ""
+}
+
+// holds tells whether the condition of the result is checked before the instruction.
+func (s *state) holds(res Result, call *ssa.CallCommon, at ssa.Instruction, visited map[*ssa.Phi]bool) bool {
+	switch res.Cond {
+	case ArgsNotNil:
+		for _, i := range res.Args {
+			if n, _ := s.nilnessOf(call.Args[i], at, visited); n != notNil {
+				return false
+			}
+		}
+
+		return true
+	case ArgTrue:
+		arg := call.Args[res.Args[0]]
+
+		return dominatingConds(at.Block(), func(cond ssa.Value, holds bool) bool {
+			return cond == arg && holds
+		})
+	case ArgNil:
+		arg := call.Args[res.Args[0]]
+
+		return isNilConst(arg) || s.checked(arg, at) == definitelyNil
+	case ArgPresent:
+		arg := call.Args[res.Args[0]]
+
+		return dominatingConds(at.Block(), func(cond ssa.Value, holds bool) bool {
+			return isOptCheck(cond, arg, holds)
+		})
+	default:
+		return false
+	}
+}
+
+// checked looks for nil checks dominating the instruction, including the ones made by ref conversions.
+func (s *state) checked(val ssa.Value, at ssa.Instruction) nilness {
+	res := unknownNil
+
+	dominatingConds(at.Block(), func(cond ssa.Value, holds bool) bool {
+		res = s.condNilness(val, cond, holds)
+
+		return res != unknownNil
+	})
+
+	if res == unknownNil && s.asserted(val, at) {
+		return notNil
+	}
+
+	return res
+}
+
+// condNilness tells what the condition with the known outcome says about the pointer:
+// `p == nil` and `p != nil` along with `err == nil` and `err != nil` for errors of ref.FromPtr(p).
+func (s *state) condNilness(val, cond ssa.Value, holds bool) nilness {
+	binop, ok := cond.(*ssa.BinOp)
+	if !ok || (binop.Op != token.EQL && binop.Op != token.NEQ) {
+		return unknownNil
+	}
+
+	operand := binop.X
+	if isNilConst(operand) {
+		operand = binop.Y
+	} else if !isNilConst(binop.Y) {
+		return unknownNil
+	}
+
+	if operand != val {
+		res, call, _ := s.result(operand)
+		if res.Nilness != ErrorIfNil || call.Args[res.Args[0]] != val {
+			return unknownNil
+		}
+	}
+
+	// nil errors of ref.FromPtr mean not nil pointers, the opposite of the pointers themselves
+	if operandNil := (binop.Op == token.EQL) == holds; operandNil == (operand == val) {
+		return definitelyNil
+	}
+
+	return notNil
+}
+
+// asserted tells whether the pointer is passed to a function requiring it not to be nil before the instruction,
+// e.g. ref.Guaranteed.
+func (s *state) asserted(val ssa.Value, at ssa.Instruction) bool {
+	referrers := val.Referrers()
+	if referrers == nil {
+		return false
+	}
+
+	for _, instr := range *referrers {
+		call, ok := instr.(*ssa.Call)
+		if !ok || !precedes(call, at) {
+			continue
+		}
+
+		contract, _ := s.callContract(&call.Call)
+		if contract == nil {
+			continue
+		}
+
+		for _, i := range contract.NotNil {
+			if i < len(call.Call.Args) && call.Call.Args[i] == val {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// dominatingConds calls the function with the If conditions dominating the block, and their outcomes,
+// until it returns true.
+func dominatingConds(block *ssa.BasicBlock, fn func(cond ssa.Value, holds bool) bool) bool {
+	for ; block != nil; block = block.Idom() {
+		if len(block.Preds) != 1 {
+			continue
+		}
+
+		pred := block.Preds[0]
+
+		cond, ok := pred.Instrs[len(pred.Instrs)-1].(*ssa.If)
+		if !ok || pred.Succs[0] == pred.Succs[1] {
+			continue
+		}
+
+		if fn(cond.Cond, block == pred.Succs[0]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isOptCheck tells whether the condition with the outcome means the option is present: o.IsPresent() or o.IsMissing().
+func isOptCheck(cond, opt ssa.Value, holds bool) bool {
+	call, ok := cond.(*ssa.Call)
+	if !ok || call.Call.IsInvoke() || len(call.Call.Args) != 1 || call.Call.Args[0] != opt {
+		return false
+	}
+
+	callee := call.Call.StaticCallee()
+	if callee == nil {
+		return false
+	}
+
+	fn, ok := callee.Object().(*types.Func)
+	if !ok || fn.Signature().Recv() == nil || !analysisutil.IsNamed(fn.Signature().Recv().Type(), analysisutil.OptPath, "Opt") {
+		return false
+	}
+
+	switch fn.Name() {
+	case "IsPresent":
+		return holds
+	case "IsMissing":
+		return !holds
+	default:
+		return false
+	}
+}
+
+// precedes tells whether the instruction a is executed before b on every path.
+func precedes(a, b ssa.Instruction) bool {
+	if a.Block() != b.Block() {
+		return a.Block().Dominates(b.Block())
+	}
+
+	return slices.Index(a.Block().Instrs, a) < slices.Index(b.Block().Instrs, b)
+}
+
+func isNilConst(val ssa.Value) bool {
+	c, ok := val.(*ssa.Const)
+
+	return ok && c.IsNil()
+}
+
+// funcName formats the function qualified by its package name, e.g. ptr.Coalesce or opt.Opt.Ptr.
+func funcName(fn *types.Func) string {
+	name := fn.Name()
+
+	if recv := fn.Signature().Recv(); recv != nil {
+		typ := recv.Type()
+		if ptr, ok := typ.(*types.Pointer); ok {
+			typ = ptr.Elem()
+		}
+
+		if named, ok := types.Unalias(typ).(*types.Named); ok {
+			name = named.Obj().Name() + "." + name
+		}
+	}
+
+	if fn.Pkg() == nil {
+		return name
+	}
+
+	return fn.Pkg().Name() + "." + name
+}
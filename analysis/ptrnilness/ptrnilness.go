@@ -0,0 +1,159 @@
+// Package ptrnilness defines an Analyzer reporting dereferences of possibly nil pointers returned by
+// the functions of the ptr and opt packages, the contracts the standard nilness analyzer does not know:
+//
+//	p := ptr.Coalesce(a, b)
+//	_ = *p // dereference of possibly nil result of ptr.Coalesce
+//
+// Results are not reported when the conditions making them not nil are checked:
+//   - ptr.Apply* and the functions returned by ptr.Monad* after the pointer arguments are checked,
+//   - ptr.FromOk after the ok flag is checked and ptr.FromErr after the error is checked,
+//   - opt.Opt.Ptr after IsPresent or IsMissing is checked,
+//   - pointers checked against nil, passed to ref.Guaranteed or to ref.FromPtr with the error checked.
+//
+// Results of ptr.Of and ref.Ref.Ptr (e.g. ptr.Else(...).Ptr()) are never nil.
+//
+// The contracts are exported as facts, so they are inferred for user functions returning such results too:
+// a function returning ptr.Coalesce(a, b) may return nil as well.
+package ptrnilness
+
+import (
+	"go/token"
+	"go/types"
+	"reflect"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ssa"
+
+	"github.com/sr9000/go-ptr-tools/analysis/internal/analysisutil"
+)
+
+// Analyzer reports dereferences of possibly nil pointers returned by ptr and opt functions.
+var Analyzer = &analysis.Analyzer{ //nolint:gochecknoglobals // analyzers are declared as globals
+	Name:      "ptrnilness",
+	Doc:       "report dereferences of possibly nil pointers returned by ptr and opt functions",
+	URL:       "https://pkg.go.dev/github.com/sr9000/go-ptr-tools/analysis/ptrnilness",
+	Run:       run,
+	FactTypes: []analysis.Fact{(*Contract)(nil)},
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	switch pass.Pkg.Path() {
+	case analysisutil.PtrPath, analysisutil.RefPath, analysisutil.OptPath:
+		for fn, contract := range libraryContracts(pass.Pkg) {
+			pass.ExportObjectFact(fn, contract)
+		}
+
+		return nil, nil //nolint:nilnil // no result
+	default:
+	}
+
+	// SSA is built only for packages using the contracts, the facts are required for all dependencies.
+	if !importsContracts(pass) {
+		return nil, nil //nolint:nilnil // no result
+	}
+
+	s := &state{pass: pass, local: make(map[*types.Func]*Contract)}
+	funcs := analysisutil.BuildSSA(pass)
+
+	s.inferAll(funcs)
+
+	for fn, contract := range s.local {
+		if contract != nil {
+			pass.ExportObjectFact(fn, contract)
+		}
+	}
+
+	for _, fn := range funcs {
+		s.check(fn)
+	}
+
+	return nil, nil //nolint:nilnil // no result
+}
+
+// importsContracts tells whether any of the packages imported directly has contracts.
+func importsContracts(pass *analysis.Pass) bool {
+	imports := make(map[*types.Package]bool)
+	for _, pkg := range pass.Pkg.Imports() {
+		imports[pkg] = true
+	}
+
+	for _, fact := range pass.AllObjectFacts() {
+		if imports[fact.Object.Pkg()] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// inferAll infers the contracts of the package functions, until they do not change.
+func (s *state) inferAll(funcs []*ssa.Function) {
+	for range len(funcs) + 1 {
+		changed := false
+
+		for _, fn := range funcs {
+			obj, ok := fn.Object().(*types.Func)
+			if !ok || fn.Parent() != nil {
+				continue
+			}
+
+			if contract := s.infer(fn); !reflect.DeepEqual(s.local[obj], contract) {
+				s.local[obj] = contract
+				changed = true
+			}
+		}
+
+		if !changed {
+			return
+		}
+	}
+}
+
+// check reports dereferences of possibly nil results, once per value.
+func (s *state) check(fn *ssa.Function) {
+	reported := make(map[ssa.Value]bool)
+
+	for _, block := range fn.Blocks {
+		for _, instr := range block.Instrs {
+			val := dereferenced(instr)
+			if val == nil || reported[val] {
+				continue
+			}
+
+			res, origin := s.nilnessOf(val, instr, make(map[*ssa.Phi]bool))
+			if res != possiblyNil {
+				continue
+			}
+
+			reported[val] = true
+
+			pos := instr.Pos()
+			if !pos.IsValid() {
+				pos = val.Pos()
+			}
+
+			s.pass.Reportf(pos, "dereference of possibly nil result of %s", origin)
+		}
+	}
+}
+
+// dereferenced returns the pointer the instruction dereferences, or nil.
+func dereferenced(instr ssa.Instruction) ssa.Value {
+	switch instr := instr.(type) {
+	case *ssa.UnOp:
+		if instr.Op == token.MUL {
+			return instr.X
+		}
+	case *ssa.FieldAddr:
+		return instr.X
+	case *ssa.IndexAddr:
+		if _, ok := instr.X.Type().Underlying().(*types.Pointer); ok {
+			return instr.X
+		}
+	case *ssa.Store:
+		return instr.Addr
+	default:
+	}
+
+	return nil
+}
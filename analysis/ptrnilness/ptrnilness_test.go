@@ -0,0 +1,33 @@
+package ptrnilness_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/sr9000/go-ptr-tools/analysis/ptrnilness"
+)
+
+func TestAnalyzer(t *testing.T) {
+	t.Parallel()
+
+	analysistest.Run(t, analysistest.TestData(), ptrnilness.Analyzer, "wrap", "example")
+}
+
+// TestMonadStub checks that the ptr stub has the generated Apply and Monad functions of the real package,
+// copy ptr/monad.go into testdata after regenerating it.
+func TestMonadStub(t *testing.T) {
+	t.Parallel()
+
+	expected, err := os.ReadFile(filepath.Join("..", "..", "ptr", "monad.go"))
+	require.NoError(t, err)
+
+	stub := filepath.Join(analysistest.TestData(), "src", "github.com", "sr9000", "go-ptr-tools", "ptr", "monad.go")
+
+	actual, err := os.ReadFile(stub)
+	require.NoError(t, err)
+	require.Equal(t, string(expected), string(actual))
+}
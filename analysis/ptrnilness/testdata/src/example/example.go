@@ -0,0 +1,275 @@
+package example
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"wrap"
+
+	"github.com/sr9000/go-ptr-tools/opt"
+	"github.com/sr9000/go-ptr-tools/ptr"
+	"github.com/sr9000/go-ptr-tools/ref"
+)
+
+type user struct {
+	name string
+}
+
+func coalesce(a, b *int) int {
+	p := ptr.Coalesce(a, b)
+
+	return *p // want `dereference of possibly nil result of ptr.Coalesce`
+}
+
+func coalesceChecked(a, b *int) int {
+	p := ptr.Coalesce(a, b)
+	if p == nil {
+		return 0
+	}
+
+	return *p
+}
+
+func coalesceOnce(a, b *int) int {
+	p := ptr.Coalesce(a, b)
+	x := *p // want `dereference of possibly nil result of ptr.Coalesce`
+
+	return x + *p
+}
+
+func field(a, b *user) string {
+	return ptr.Coalesce(a, b).name // want `dereference of possibly nil result of ptr.Coalesce`
+}
+
+func store(a, b *int) {
+	*ptr.Coalesce(a, b) = 1 // want `dereference of possibly nil result of ptr.Coalesce`
+}
+
+func elseRef(a, b *int) int {
+	return *ptr.Else(ref.Of(0), a, b).Ptr()
+}
+
+func fromOk(m map[string]int) int {
+	v, ok := m["a"]
+	p := ptr.FromOk(v, ok)
+
+	if ok {
+		return *p
+	}
+
+	return *p // want `dereference of possibly nil result of ptr.FromOk`
+}
+
+func fromErr(s string) int {
+	v, err := strconv.Atoi(s)
+	p := ptr.FromErr(v, err)
+
+	if err != nil {
+		return *p // want `dereference of possibly nil result of ptr.FromErr`
+	}
+
+	return *p
+}
+
+func fromErrCall(s string) int {
+	return *ptr.FromErr(strconv.Atoi(s)) // want `dereference of possibly nil result of ptr.FromErr`
+}
+
+func apply(p *int) string {
+	s := ptr.Apply(p, strconv.Itoa)
+
+	return *s // want `dereference of possibly nil result of ptr.Apply`
+}
+
+func applyChecked(p *int) string {
+	if p != nil {
+		return *ptr.Apply(p, strconv.Itoa)
+	}
+
+	return ""
+}
+
+func applyOf(v int) string {
+	return *ptr.Apply(ptr.Of(v), strconv.Itoa)
+}
+
+func apply2(a, b *int) int {
+	if a == nil {
+		return 0
+	}
+
+	return *ptr.Apply2(a, b, func(x, y int) int { return x + y }) // want `dereference of possibly nil result of ptr.Apply2`
+}
+
+func apply2Checked(a, b *int) int {
+	if a != nil && b != nil {
+		return *ptr.Apply2(a, b, func(x, y int) int { return x + y })
+	}
+
+	return 0
+}
+
+func applyCtxErr(ctx context.Context, p *int) (int, error) {
+	r, err := ptr.ApplyCtxErr(ctx, p, func(_ context.Context, v int) (int, error) { return v, nil })
+	if err != nil {
+		return 0, err
+	}
+
+	return *r, nil // want `dereference of possibly nil result of ptr.ApplyCtxErr`
+}
+
+func apply12(p *int) int {
+	q, r := ptr.Apply12(p, func(v int) (int, int) { return v / 2, v % 2 })
+
+	return *q + *r // want `dereference of possibly nil result of ptr.Apply12` `dereference of possibly nil result of ptr.Apply12`
+}
+
+func apply12Checked(p *int) int {
+	if p == nil {
+		return 0
+	}
+
+	q, r := ptr.Apply12(p, func(v int) (int, int) { return v / 2, v % 2 })
+
+	return *q + *r
+}
+
+func applyErr(s *string) int {
+	n, err := ptr.ApplyErr(s, strconv.Atoi)
+	if err != nil {
+		return 0
+	}
+
+	return *n // want `dereference of possibly nil result of ptr.ApplyErr`
+}
+
+func applyVoid(p *int) int {
+	sum := 0
+	ptr.ApplyVoid(p, func(v int) { sum += v })
+	ptr.Apply2VoidErr(p, p, func(x, y int) error { sum += x * y; return nil })
+
+	return sum
+}
+
+func monad(p *int) string {
+	itoa := ptr.Monad(strconv.Itoa)
+
+	if p != nil {
+		_ = *itoa(p)
+	}
+
+	return *itoa(p) // want `dereference of possibly nil result of function returned by ptr.Monad`
+}
+
+func monad2(a, b *int) int {
+	return *ptr.Monad2(func(x, y int) int { return x + y })(a, b) // want `dereference of possibly nil result of function returned by ptr.Monad2`
+}
+
+func monad22(a, b *int) int {
+	divmod := ptr.Monad22(func(x, y int) (int, int) { return x / y, x % y })
+	_, r := divmod(a, b)
+
+	return *r // want `dereference of possibly nil result of function returned by ptr.Monad22`
+}
+
+func monadCtxErr(ctx context.Context, p *int) int {
+	atoi := ptr.MonadCtxErr(func(_ context.Context, v int) (int, error) { return v, nil })
+	r, _ := atoi(ctx, p)
+
+	return *r // want `dereference of possibly nil result of function returned by ptr.MonadCtxErr`
+}
+
+func chain(p *user) string {
+	return *ptr.Chain(p, func(u *user) *string { return &u.name }) // want `dereference of possibly nil result of ptr.Chain`
+}
+
+func optPtr(o opt.Opt[int]) int {
+	if o.IsPresent() {
+		return *o.Ptr()
+	}
+
+	if o.IsMissing() {
+		return 0
+	}
+
+	return *o.Ptr()
+}
+
+func optPtrUnchecked(p *int) int {
+	return *opt.FromPtr(p).Ptr() // want `dereference of possibly nil result of opt.Opt.Ptr`
+}
+
+func guaranteed(a, b *int) int {
+	p := ptr.Coalesce(a, b)
+	r := ref.Guaranteed(p)
+
+	return *p + r.Val()
+}
+
+func fromPtr(a, b *int) (int, error) {
+	p := ptr.Coalesce(a, b)
+
+	r, err := ref.FromPtr(p)
+	if err != nil {
+		return 0, err
+	}
+
+	return *p + *r.Ptr(), nil
+}
+
+func fromPtrMissed(a, b *int) (int, error) {
+	p := ptr.Coalesce(a, b)
+
+	r, err := ref.FromPtr(p)
+	if errors.Is(err, context.Canceled) {
+		return 0, err
+	}
+
+	return *p + r.Val(), nil // want `dereference of possibly nil result of ptr.Coalesce`
+}
+
+func phi(a, b *int, c bool) int {
+	p := ptr.Of(0)
+	if c {
+		p = ptr.Coalesce(a, b)
+	}
+
+	return *p // want `dereference of possibly nil result of ptr.Coalesce`
+}
+
+func wrappers(a *int, m map[string]int, o opt.Opt[int]) int {
+	x := *wrap.First(a, nil) // want `dereference of possibly nil result of wrap.First`
+	x += *wrap.Parse("1")    // want `dereference of possibly nil result of wrap.Parse`
+	x += *wrap.Lookup(m, "") // want `dereference of possibly nil result of wrap.Lookup`
+	x += *wrap.Default(a)
+	x += *wrap.Checked(a)
+	x += *wrap.Trusted(a)
+
+	if a != nil {
+		x += *wrap.Double(a)
+	}
+
+	if _, err := wrap.Wrap(a); err == nil {
+		x += *a
+	}
+
+	if o.IsPresent() {
+		x += *wrap.Value(o)
+	}
+
+	return x + *wrap.Double(a) // want `dereference of possibly nil result of wrap.Double`
+}
+
+func local(a *int) int {
+	return *first(a) // want `dereference of possibly nil result of example.first`
+}
+
+func first(a *int) *int { // want first:"maybe nil"
+	return ptr.Coalesce(a)
+}
+
+func closure(a, b *int) func() int {
+	return func() int {
+		return *ptr.Coalesce(a, b) // want `dereference of possibly nil result of ptr.Coalesce`
+	}
+}
@@ -0,0 +1,28 @@
+package opt
+
+type Opt[T any] struct {
+	val T
+	ok  bool
+}
+
+func Of[T any](val T) Opt[T] { return Opt[T]{val: val, ok: true} }
+
+func FromPtr[T any](ptr *T) (o Opt[T]) {
+	if ptr != nil {
+		return Opt[T]{val: *ptr, ok: true}
+	}
+
+	return
+}
+
+func (o Opt[T]) IsPresent() bool { return o.ok }
+
+func (o Opt[T]) IsMissing() bool { return !o.ok }
+
+func (o Opt[T]) Ptr() *T {
+	if !o.ok {
+		return nil
+	}
+
+	return &o.val
+}
@@ -0,0 +1,47 @@
+package ptr
+
+import "github.com/sr9000/go-ptr-tools/ref"
+
+func Of[T any](v T) *T { return &v }
+
+func FromOk[T any](val T, ok bool) *T {
+	if ok {
+		return &val
+	}
+
+	return nil
+}
+
+func FromErr[T any](val T, err error) *T {
+	if err == nil {
+		return &val
+	}
+
+	return nil
+}
+
+func Coalesce[T any](pointers ...*T) *T {
+	for _, ptr := range pointers {
+		if ptr != nil {
+			return ptr
+		}
+	}
+
+	return nil
+}
+
+func Else[T any](final ref.Ref[T], pointers ...*T) ref.Ref[T] {
+	if p := Coalesce(pointers...); p != nil {
+		return ref.Guaranteed(p)
+	}
+
+	return final
+}
+
+func Chain[T1, R any](t1 *T1, f1 func(t1 *T1) *R) (r *R) {
+	if t1 == nil {
+		return
+	}
+
+	return f1(t1)
+}
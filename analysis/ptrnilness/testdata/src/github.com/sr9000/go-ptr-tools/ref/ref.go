@@ -0,0 +1,21 @@
+package ref
+
+import "errors"
+
+type Ref[T any] struct{ ptr *T }
+
+func Of[T any](v T) Ref[T] { return Ref[T]{ptr: &v} }
+
+func Guaranteed[T any](notNilPtr *T) Ref[T] { return Ref[T]{ptr: notNilPtr} }
+
+func FromPtr[T any](ptr *T) (Ref[T], error) {
+	if ptr == nil {
+		return Ref[T]{}, errors.New("nil pointer")
+	}
+
+	return Ref[T]{ptr: ptr}, nil
+}
+
+func (r Ref[T]) Ptr() *T { return r.ptr }
+
+func (r Ref[T]) Val() T { return *r.ptr }
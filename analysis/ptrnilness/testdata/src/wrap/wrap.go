@@ -0,0 +1,58 @@
+package wrap
+
+import (
+	"strconv"
+
+	"github.com/sr9000/go-ptr-tools/opt"
+	"github.com/sr9000/go-ptr-tools/ptr"
+	"github.com/sr9000/go-ptr-tools/ref"
+)
+
+func First(a, b *int) *int { // want First:"maybe nil"
+	return ptr.Coalesce(a, b)
+}
+
+func Parse(s string) *int { // want Parse:"maybe nil"
+	return ptr.FromErr(strconv.Atoi(s))
+}
+
+func Double(p *int) *int { // want Double:"nil unless \\[0\\] not nil"
+	return ptr.Apply(p, func(v int) int { return 2 * v })
+}
+
+func Lookup(m map[string]int, key string) *int { // want Lookup:"maybe nil"
+	v, ok := m[key]
+	if !ok {
+		return nil
+	}
+
+	return First(&v, nil)
+}
+
+func Default(p *int) *int { // want Default:"never nil"
+	return ptr.Else(ref.Of(0), p).Ptr()
+}
+
+func Checked(p *int) *int { // want Checked:"never nil"
+	if p == nil {
+		return ptr.Of(0)
+	}
+
+	return ptr.Apply(p, func(v int) int { return v + 1 })
+}
+
+func Wrap(p *int) (ref.Ref[int], error) { // want Wrap:"-, error if \\[0\\] is nil"
+	return ref.FromPtr(p)
+}
+
+func Value(o opt.Opt[int]) *int { // want Value:"nil unless \\[0\\] is present"
+	return o.Ptr()
+}
+
+func Trusted(p *int) *int {
+	if p == nil {
+		return nil
+	}
+
+	return p
+}
@@ -0,0 +1,147 @@
+package refcheck
+
+import (
+	"go/token"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ssa"
+
+	"github.com/sr9000/go-ptr-tools/analysis/internal/analysisutil"
+)
+
+// nilness is the knowledge about a pointer being nil.
+type nilness int
+
+const (
+	unknownNil nilness = iota // trusted, e.g. a parameter
+	notNil
+	possiblyNil
+	definitelyNil
+)
+
+// checkGuaranteed reports ref.Guaranteed calls with nil or possibly nil pointers.
+func checkGuaranteed(pass *analysis.Pass, funcs []*ssa.Function) {
+	for _, fn := range funcs {
+		if isTest(pass, fn.Pos()) {
+			continue
+		}
+
+		for _, block := range fn.Blocks {
+			for _, instr := range block.Instrs {
+				call, ok := instr.(*ssa.Call)
+				if !ok {
+					continue
+				}
+
+				callee := call.Call.StaticCallee()
+				if callee == nil || callee.Object() == nil || !analysisutil.IsFunc(callee.Object(), analysisutil.RefPath, "Guaranteed") {
+					continue
+				}
+
+				switch nilnessOf(call.Call.Args[0], block, make(map[*ssa.Phi]bool)) {
+				case definitelyNil:
+					pass.Reportf(call.Pos(), "ref.Guaranteed called with nil pointer")
+				case possiblyNil:
+					pass.Reportf(call.Pos(), "ref.Guaranteed called with possibly nil pointer, use ref.FromPtr")
+				default:
+				}
+			}
+		}
+	}
+}
+
+// nilnessOf tells whether the pointer v used in the block may be nil.
+func nilnessOf(val ssa.Value, block *ssa.BasicBlock, visited map[*ssa.Phi]bool) nilness {
+	if res := checkedNilness(val, block); res != unknownNil {
+		return res
+	}
+
+	switch val := val.(type) {
+	case *ssa.Const:
+		if val.IsNil() {
+			return definitelyNil
+		}
+	case *ssa.ChangeType:
+		return nilnessOf(val.X, block, visited)
+	case *ssa.Lookup:
+		if !val.CommaOk {
+			return possiblyNil // missing keys yield nil
+		}
+	case *ssa.Alloc, *ssa.FieldAddr, *ssa.IndexAddr:
+		return notNil
+	case *ssa.Phi:
+		return phiNilness(val, visited)
+	default:
+	}
+
+	return unknownNil
+}
+
+// phiNilness merges the nilness of phi edges, each edge is considered in its predecessor block.
+func phiNilness(phi *ssa.Phi, visited map[*ssa.Phi]bool) nilness {
+	if visited[phi] {
+		return unknownNil
+	}
+
+	visited[phi] = true
+
+	nils := 0
+
+	for i, edge := range phi.Edges {
+		switch nilnessOf(edge, phi.Block().Preds[i], visited) {
+		case definitelyNil:
+			nils++
+		case possiblyNil:
+			return possiblyNil
+		default:
+		}
+	}
+
+	switch nils {
+	case 0:
+		return unknownNil
+	case len(phi.Edges):
+		return definitelyNil
+	default:
+		return possiblyNil
+	}
+}
+
+// checkedNilness looks for `v == nil` or `v != nil` conditions dominating the block.
+func checkedNilness(val ssa.Value, block *ssa.BasicBlock) nilness {
+	for ; block != nil; block = block.Idom() {
+		if len(block.Preds) != 1 {
+			continue
+		}
+
+		pred := block.Preds[0]
+
+		cond, ok := pred.Instrs[len(pred.Instrs)-1].(*ssa.If)
+		if !ok {
+			continue
+		}
+
+		binop, ok := cond.Cond.(*ssa.BinOp)
+		if !ok || (binop.Op != token.EQL && binop.Op != token.NEQ) {
+			continue
+		}
+
+		if !(binop.X == val && isNilConst(binop.Y) || binop.Y == val && isNilConst(binop.X)) {
+			continue
+		}
+
+		if (binop.Op == token.EQL) == (block == pred.Succs[0]) {
+			return definitelyNil
+		}
+
+		return notNil
+	}
+
+	return unknownNil
+}
+
+func isNilConst(val ssa.Value) bool {
+	c, ok := val.(*ssa.Const)
+
+	return ok && c.IsNil()
+}
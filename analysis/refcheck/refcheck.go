@@ -0,0 +1,108 @@
+// Package refcheck defines an Analyzer reporting misuse of ref.Ref, the "always valid pointer".
+//
+// The zero value of ref.Ref holds a nil pointer, so the analyzer reports the ways to get one:
+//   - ref.Ref fields of structs, zero structs hold nil Refs,
+//   - ref.Guaranteed called with nil or with a pointer that is nil on some path,
+//   - zero value literals ref.Ref[T]{} except returned ones (along with an error),
+//   - `var r ref.Ref[T]` declarations used before assignment.
+//
+// Pointers of unknown origin (parameters, call results) passed to ref.Guaranteed are trusted,
+// it is the purpose of the function. Use ref.FromPtr when a pointer may be nil.
+//
+// Test files are not checked, tests build nil Refs and structs with ref.Ref fields on purpose.
+package refcheck
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/ctrlflow"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"github.com/sr9000/go-ptr-tools/analysis/internal/analysisutil"
+)
+
+// Analyzer reports misuse of ref.Ref.
+var Analyzer = &analysis.Analyzer{ //nolint:gochecknoglobals // analyzers are declared as globals
+	Name:     "refcheck",
+	Doc:      "report ref.Ref values holding nil pointers",
+	URL:      "https://pkg.go.dev/github.com/sr9000/go-ptr-tools/analysis/refcheck",
+	Requires: []*analysis.Analyzer{ctrlflow.Analyzer},
+	Run:      run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	if pass.Pkg.Path() == analysisutil.RefPath {
+		return nil, nil //nolint:nilnil // no result
+	}
+
+	files := slices.DeleteFunc(slices.Clone(pass.Files), func(file *ast.File) bool { return isTest(pass, file.Pos()) })
+	insp := inspector.New(files)
+	cfgs, _ := pass.ResultOf[ctrlflow.Analyzer].(*ctrlflow.CFGs)
+
+	checkFields(pass, insp)
+	checkLiterals(pass, insp)
+	checkUnassigned(pass, insp, cfgs)
+
+	// SSA is built only for packages calling ref.Guaranteed, the rest are analyzed for facts of ctrlflow
+	if importsRef(pass) {
+		checkGuaranteed(pass, analysisutil.BuildSSA(pass))
+	}
+
+	return nil, nil //nolint:nilnil // no result
+}
+
+// isTest tells whether pos is in a test file.
+func isTest(pass *analysis.Pass, pos token.Pos) bool {
+	return strings.HasSuffix(pass.Fset.File(pos).Name(), "_test.go")
+}
+
+// importsRef tells whether the package imports ref directly.
+func importsRef(pass *analysis.Pass) bool {
+	return slices.ContainsFunc(pass.Pkg.Imports(), func(pkg *types.Package) bool {
+		return pkg.Path() == analysisutil.RefPath
+	})
+}
+
+// checkFields reports struct fields of ref.Ref type.
+func checkFields(pass *analysis.Pass, insp *inspector.Inspector) {
+	for node := range insp.PreorderSeq((*ast.StructType)(nil)) {
+		for _, field := range node.(*ast.StructType).Fields.List { //nolint:forcetypeassert // filtered above
+			if typ := pass.TypesInfo.TypeOf(field.Type); isRef(typ) {
+				pass.ReportRangef(field, "struct field of type %s: the zero struct holds a nil pointer", typeString(typ))
+			}
+		}
+	}
+}
+
+// checkLiterals reports zero value literals of ref.Ref, except results of return statements.
+func checkLiterals(pass *analysis.Pass, insp *inspector.Inspector) {
+	returned := make(map[ast.Expr]bool)
+
+	for node := range insp.PreorderSeq((*ast.ReturnStmt)(nil)) {
+		for _, res := range node.(*ast.ReturnStmt).Results { //nolint:forcetypeassert // filtered above
+			returned[ast.Unparen(res)] = true
+		}
+	}
+
+	for node := range insp.PreorderSeq((*ast.CompositeLit)(nil)) {
+		lit := node.(*ast.CompositeLit) //nolint:forcetypeassert // filtered above
+		if typ := pass.TypesInfo.TypeOf(lit); isRef(typ) && len(lit.Elts) == 0 && !returned[lit] {
+			pass.ReportRangef(lit, "zero value %s{} holds a nil pointer", typeString(typ))
+		}
+	}
+}
+
+// isRef tells whether typ is an instantiation of ref.Ref.
+func isRef(typ types.Type) bool {
+	return analysisutil.IsNamed(typ, analysisutil.RefPath, "Ref")
+}
+
+// typeString formats typ qualified by package names, e.g. ref.Ref[int].
+func typeString(typ types.Type) string {
+	return types.TypeString(typ, func(pkg *types.Package) string { return pkg.Name() })
+}
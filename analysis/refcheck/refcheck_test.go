@@ -0,0 +1,15 @@
+package refcheck_test
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/sr9000/go-ptr-tools/analysis/refcheck"
+)
+
+func TestAnalyzer(t *testing.T) {
+	t.Parallel()
+
+	analysistest.Run(t, analysistest.TestData(), refcheck.Analyzer, "example")
+}
@@ -0,0 +1,142 @@
+package example
+
+import (
+	"errors"
+
+	"github.com/sr9000/go-ptr-tools/ref"
+)
+
+type Person struct {
+	Name    ref.Ref[string] // want `struct field of type ref.Ref\[string\]: the zero struct holds a nil pointer`
+	Age     *int
+	Aliases []ref.Ref[string]
+}
+
+type Embedded struct {
+	ref.Ref[int] // want `struct field of type ref.Ref\[int\]`
+}
+
+var anonymous = struct {
+	Value ref.Ref[int] // want `struct field of type ref.Ref\[int\]`
+}{Value: ref.Of(1)}
+
+var errEmpty = errors.New("empty")
+
+func guaranteedNil() {
+	_ = ref.Guaranteed[int](nil) // want `ref.Guaranteed called with nil pointer`
+
+	var p *int
+	_ = ref.Guaranteed(p) // want `ref.Guaranteed called with nil pointer`
+}
+
+func guaranteedMaybe(cond bool, m map[string]*int) {
+	var p *int
+	if cond {
+		p = new(int)
+	}
+
+	_ = ref.Guaranteed(p)        // want `ref.Guaranteed called with possibly nil pointer, use ref.FromPtr`
+	_ = ref.Guaranteed(m["key"]) // want `ref.Guaranteed called with possibly nil pointer`
+
+	if p == nil {
+		_ = ref.Guaranteed(p) // want `ref.Guaranteed called with nil pointer`
+	}
+}
+
+func guaranteedValid(p *int, s []int, person *Person) {
+	x := 42
+	_ = ref.Guaranteed(&x)
+	_ = ref.Guaranteed(p) // trusted
+	_ = ref.Guaranteed(&s[0])
+	_ = ref.Guaranteed(&person.Aliases)
+
+	var q *int
+	if q != nil {
+		_ = ref.Guaranteed(q)
+	}
+
+	var r *int
+	if r == nil {
+		return
+	}
+
+	_ = ref.Guaranteed(r)
+
+	if v, ok := map[string]*int{}["key"]; ok {
+		_ = ref.Guaranteed(v)
+	}
+}
+
+func literals() (ref.Ref[int], error) {
+	r := ref.Ref[int]{} // want `zero value ref.Ref\[int\]{} holds a nil pointer`
+	use(r)
+	use(ref.Ref[int]{}) // want `zero value ref.Ref\[int\]{} holds a nil pointer`
+
+	var v = ref.Ref[string]{} // want `zero value ref.Ref\[string\]{} holds a nil pointer`
+	_ = v
+
+	return ref.Ref[int]{}, errEmpty
+}
+
+func unassigned(cond bool, xs []ref.Ref[int]) {
+	var a ref.Ref[int]
+	use(a) // want `a of type ref.Ref\[int\] used before assignment`
+
+	var b ref.Ref[int]
+	if cond {
+		b = ref.Of(1)
+	}
+
+	use(b) // want `b of type ref.Ref\[int\] used before assignment`
+
+	var c ref.Ref[int]
+	if cond {
+		c = ref.Of(1)
+	} else {
+		c = ref.Of(2)
+	}
+
+	use(c)
+
+	var d ref.Ref[int]
+	d = ref.Of(d.Val()) // want `d of type ref.Ref\[int\] used before assignment`
+	use(d)
+
+	for range 3 {
+		var e ref.Ref[int]
+		use(e) // want `e of type ref.Ref\[int\] used before assignment`
+		e = ref.Of(1)
+		use(e)
+	}
+
+	var f ref.Ref[int]
+	for _, f = range xs {
+	}
+
+	use(f) // want `f of type ref.Ref\[int\] used before assignment`
+
+	var g ref.Ref[int]
+	for _, g = range xs {
+		use(g)
+	}
+
+	var h ref.Ref[int]
+	set(&h)
+	use(h)
+
+	var i ref.Ref[int]
+	func() { i = ref.Of(1) }()
+	use(i)
+
+	var j ref.Ref[int]
+	if !cond {
+		return
+	}
+
+	j = ref.Of(1)
+	use(j)
+}
+
+func use(ref.Ref[int]) {}
+
+func set(r *ref.Ref[int]) { *r = ref.Of(1) }
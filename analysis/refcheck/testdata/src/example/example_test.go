@@ -0,0 +1,20 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/sr9000/go-ptr-tools/ref"
+)
+
+// test files are not checked, they build nil Refs on purpose
+type testCase struct {
+	Name ref.Ref[string]
+}
+
+func TestNil(t *testing.T) {
+	_ = testCase{Name: ref.Ref[string]{}}
+	_ = ref.Guaranteed[int](nil)
+
+	var r ref.Ref[int]
+	_ = r
+}
@@ -0,0 +1,22 @@
+// Package ref is a stub of github.com/sr9000/go-ptr-tools/ref for the analyzer tests.
+package ref
+
+type Ref[T any] struct {
+	ptr *T
+}
+
+func (r Ref[T]) Ptr() *T { return r.ptr }
+
+func (r Ref[T]) Val() T { return *r.ptr }
+
+func Of[T any](v T) Ref[T] { return Ref[T]{&v} }
+
+func Guaranteed[T any](notNilPtr *T) Ref[T] { return Ref[T]{notNilPtr} }
+
+func FromPtr[T any](ptr *T) (Ref[T], error) {
+	if ptr == nil {
+		return Ref[T]{}, nil
+	}
+
+	return Ref[T]{ptr}, nil
+}
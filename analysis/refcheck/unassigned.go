@@ -0,0 +1,287 @@
+package refcheck
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"slices"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/ctrlflow"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/cfg"
+)
+
+// checkUnassigned reports uses of `var r ref.Ref[T]` declarations on paths where r is not assigned yet.
+func checkUnassigned(pass *analysis.Pass, insp *inspector.Inspector, cfgs *ctrlflow.CFGs) {
+	for node := range insp.PreorderSeq((*ast.FuncDecl)(nil), (*ast.FuncLit)(nil)) {
+		var (
+			body  *ast.BlockStmt
+			graph *cfg.CFG
+		)
+
+		switch fn := node.(type) {
+		case *ast.FuncDecl:
+			body, graph = fn.Body, cfgs.FuncDecl(fn)
+		case *ast.FuncLit:
+			body, graph = fn.Body, cfgs.FuncLit(fn)
+		}
+
+		if body == nil || graph == nil {
+			continue
+		}
+
+		if vars := declaredRefs(pass, body); len(vars) > 0 {
+			newAssignment(pass, vars, body).run(graph)
+		}
+	}
+}
+
+// declaredRefs collects ref.Ref variables declared without a value in the body, not counting nested functions.
+// Variables referenced by nested functions or whose address is taken are skipped, they may be assigned anywhere.
+func declaredRefs(pass *analysis.Pass, body *ast.BlockStmt) map[*types.Var]int {
+	vars := make(map[*types.Var]int)
+
+	ast.Inspect(body, func(node ast.Node) bool {
+		switch node := node.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.ValueSpec:
+			if len(node.Values) > 0 {
+				break
+			}
+
+			for _, name := range node.Names {
+				if v, ok := pass.TypesInfo.Defs[name].(*types.Var); ok && isRef(v.Type()) {
+					vars[v] = len(vars)
+				}
+			}
+		default:
+		}
+
+		return true
+	})
+
+	escaped := func(expr ast.Expr) {
+		if ident, ok := ast.Unparen(expr).(*ast.Ident); ok {
+			if v, ok := pass.TypesInfo.Uses[ident].(*types.Var); ok {
+				delete(vars, v)
+			}
+		}
+	}
+
+	ast.Inspect(body, func(node ast.Node) bool {
+		switch node := node.(type) {
+		case *ast.UnaryExpr:
+			if node.Op == token.AND {
+				escaped(node.X)
+			}
+		case *ast.FuncLit:
+			ast.Inspect(node.Body, func(node ast.Node) bool {
+				if ident, ok := node.(*ast.Ident); ok {
+					escaped(ident)
+				}
+
+				return true
+			})
+
+			return false
+		default:
+		}
+
+		return true
+	})
+
+	// reindex the remaining variables
+	index := make(map[*types.Var]int, len(vars))
+	for v := range vars {
+		index[v] = len(index)
+	}
+
+	return index
+}
+
+// assignment is a "definitely assigned" dataflow analysis of ref.Ref variables.
+type assignment struct {
+	pass   *analysis.Pass
+	vars   map[*types.Var]int
+	ranged map[*ast.Ident]bool // keys and values of range statements, assigned at the start of bodies
+	report bool
+}
+
+func newAssignment(pass *analysis.Pass, vars map[*types.Var]int, body *ast.BlockStmt) *assignment {
+	ranged := make(map[*ast.Ident]bool)
+
+	ast.Inspect(body, func(node ast.Node) bool {
+		if rng, ok := node.(*ast.RangeStmt); ok && rng.Tok == token.ASSIGN {
+			for _, ident := range rangeIdents(rng) {
+				ranged[ident] = true
+			}
+		}
+
+		return true
+	})
+
+	return &assignment{pass: pass, vars: vars, ranged: ranged}
+}
+
+// run computes the states of blocks until the fixed point, then reports unassigned uses.
+func (a *assignment) run(graph *cfg.CFG) {
+	preds := make([][]*cfg.Block, len(graph.Blocks))
+
+	for _, block := range graph.Blocks {
+		for _, succ := range block.Succs {
+			preds[succ.Index] = append(preds[succ.Index], block)
+		}
+	}
+
+	// out states, nil means "not computed yet", i.e. all variables are assigned
+	outs := make([][]bool, len(graph.Blocks))
+
+	for changed := true; changed; {
+		changed = false
+
+		for _, block := range graph.Blocks {
+			if !block.Live {
+				continue
+			}
+
+			out := a.transfer(block, a.in(preds[block.Index], outs))
+			if !slices.Equal(out, outs[block.Index]) {
+				outs[block.Index], changed = out, true
+			}
+		}
+	}
+
+	a.report = true
+
+	for _, block := range graph.Blocks {
+		if block.Live {
+			a.transfer(block, a.in(preds[block.Index], outs))
+		}
+	}
+}
+
+// in intersects out states of block predecessors.
+func (a *assignment) in(preds []*cfg.Block, outs [][]bool) []bool {
+	state := make([]bool, len(a.vars))
+	for i := range state {
+		state[i] = true
+	}
+
+	for _, pred := range preds {
+		if outs[pred.Index] == nil {
+			continue
+		}
+
+		for i, ok := range outs[pred.Index] {
+			state[i] = state[i] && ok
+		}
+	}
+
+	return state
+}
+
+func (a *assignment) transfer(block *cfg.Block, state []bool) []bool {
+	// the graph evaluates keys and values before the loop, but they are assigned by iterations only
+	if rng, ok := block.Stmt.(*ast.RangeStmt); ok && block.Kind == cfg.KindRangeBody && rng.Tok == token.ASSIGN {
+		for _, ident := range rangeIdents(rng) {
+			if idx, ok := a.index(a.pass.TypesInfo.Uses[ident]); ok {
+				state[idx] = true
+			}
+		}
+	}
+
+	for _, node := range block.Nodes {
+		a.node(node, state)
+	}
+
+	return state
+}
+
+func (a *assignment) node(node ast.Node, state []bool) {
+	switch node := node.(type) {
+	case *ast.DeclStmt:
+		if decl, ok := node.Decl.(*ast.GenDecl); ok {
+			for _, spec := range decl.Specs {
+				a.node(spec, state)
+			}
+		}
+	case *ast.ValueSpec:
+		for _, val := range node.Values {
+			a.use(val, state)
+		}
+
+		for _, name := range node.Names {
+			if idx, ok := a.index(a.pass.TypesInfo.Defs[name]); ok {
+				state[idx] = false
+			}
+		}
+	case *ast.AssignStmt:
+		for _, rhs := range node.Rhs {
+			a.use(rhs, state)
+		}
+
+		for _, lhs := range node.Lhs {
+			if ident, ok := lhs.(*ast.Ident); ok {
+				if idx, ok := a.index(a.pass.TypesInfo.ObjectOf(ident)); ok {
+					state[idx] = true
+
+					continue
+				}
+			}
+
+			a.use(lhs, state)
+		}
+	default:
+		a.use(node, state)
+	}
+}
+
+// use walks the node reporting unassigned variables.
+func (a *assignment) use(node ast.Node, state []bool) {
+	ast.Inspect(node, func(node ast.Node) bool {
+		switch node := node.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.Ident:
+			idx, ok := a.index(a.pass.TypesInfo.Uses[node])
+			if !ok || state[idx] || a.ranged[node] {
+				break
+			}
+
+			if a.report {
+				a.pass.ReportRangef(node, "%s of type %s used before assignment", node.Name, typeString(a.pass.TypesInfo.TypeOf(node)))
+			}
+
+			state[idx] = true // report once per path
+		default:
+		}
+
+		return true
+	})
+}
+
+func (a *assignment) index(obj types.Object) (int, bool) {
+	v, ok := obj.(*types.Var)
+	if !ok {
+		return 0, false
+	}
+
+	idx, ok := a.vars[v]
+
+	return idx, ok
+}
+
+// rangeIdents returns the key and value identifiers of the range statement.
+func rangeIdents(rng *ast.RangeStmt) []*ast.Ident {
+	var idents []*ast.Ident
+
+	for _, expr := range []ast.Expr{rng.Key, rng.Value} {
+		if ident, ok := expr.(*ast.Ident); ok {
+			idents = append(idents, ident)
+		}
+	}
+
+	return idents
+}
@@ -0,0 +1,21 @@
+// Command optcheck runs the optcheck analyzer reporting misuse of opt.Opt values.
+//
+// Run it standalone or as a vet tool:
+//
+//	go install github.com/sr9000/go-ptr-tools/cmd/optcheck
+//	optcheck ./...
+//	optcheck -fix ./... # applies suggested fixes
+//	go vet -vettool=$(which optcheck) ./...
+//
+// See the package github.com/sr9000/go-ptr-tools/analysis/optcheck for the reported issues and fixes.
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/singlechecker"
+
+	"github.com/sr9000/go-ptr-tools/analysis/optcheck"
+)
+
+func main() {
+	singlechecker.Main(optcheck.Analyzer)
+}
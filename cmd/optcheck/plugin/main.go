@@ -0,0 +1,31 @@
+// Command plugin is the golangci-lint plugin of the optcheck analyzer.
+//
+// Build it with the same versions of Go and dependencies as golangci-lint itself:
+//
+//	go build -buildmode=plugin -o optcheck.so github.com/sr9000/go-ptr-tools/cmd/optcheck/plugin
+//
+// Then register it in .golangci.yaml:
+//
+//	linters:
+//	  enable:
+//	    - optcheck
+//	  settings:
+//	    custom:
+//	      optcheck:
+//	        path: optcheck.so
+//	        description: reports misuse of opt.Opt values
+package main
+
+import (
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/sr9000/go-ptr-tools/analysis/optcheck"
+)
+
+// New is looked up by golangci-lint when loading the plugin, the analyzer has no settings.
+func New(any) ([]*analysis.Analyzer, error) {
+	return []*analysis.Analyzer{optcheck.Analyzer}, nil
+}
+
+// main is never called, plugins are loaded by golangci-lint.
+func main() {}
@@ -0,0 +1,28 @@
+// Command ptrfix rewrites manual nil-check blocks with functions of the ptr and opt packages.
+//
+// It reports the blocks and, with -fix, applies the rewrites keeping comments of rewritten statements.
+// With -diff the changes are printed as a unified diff instead, a dry run for code review:
+//
+//	go install github.com/sr9000/go-ptr-tools/cmd/ptrfix
+//	ptrfix ./...            # report the blocks
+//	ptrfix -fix -diff ./... # dry run
+//	ptrfix -fix ./...       # rewrite files
+//
+// Recognized shapes are listed by the package github.com/sr9000/go-ptr-tools/analysis/ptrfix, for example:
+//
+//	if p != nil && q != nil { f(*p, *q) } // ptr.Apply2Void(p, q, f)
+//	if v, ok := m[k]; ok { f(v) }         // v, ok := m[k]; opt.ApplyVoid(opt.FromOk(v, ok), f)
+//	if a != nil { return a }; return b    // return ptr.Coalesce(a, b)
+//
+// Run gofmt or goimports afterwards if imports have to be grouped.
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/singlechecker"
+
+	"github.com/sr9000/go-ptr-tools/analysis/ptrfix"
+)
+
+func main() {
+	singlechecker.Main(ptrfix.Analyzer)
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	_ "embed"
+
+	"errors"
+	"fmt"
+	"slices"
+	"text/template"
+
+	"github.com/sr9000/go-ptr-tools/internal/gen"
+)
+
+const (
+	generatorName        = "ptrgetters"
+	ownerWritePermission = 0o644
+)
+
+var (
+	//go:embed tmpl/getters.gotmpl
+	gettersRaw string
+
+	errUnknownType = errors.New("struct not found")
+)
+
+type getterStruct struct {
+	*gen.Struct
+
+	Fields []getterField
+}
+
+type getterField struct {
+	*gen.Field
+
+	Get, Ptr, Opt, Has, Set bool // whether to generate the method, XPtr only for pointees getting accessors too
+}
+
+// render generates the code of accessors for structs of the package in dir, or for the listed ones.
+func render(dir, output string, types []string) ([]byte, error) {
+	pkg, err := gen.Load(dir, func(filename string) bool { return filename == output })
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range types {
+		if _, ok := pkg.Struct(name); !ok {
+			return nil, fmt.Errorf("%w: %s", errUnknownType, name)
+		}
+	}
+
+	selected := func(s *gen.Struct) bool {
+		return (len(types) == 0 || slices.Contains(types, s.Name)) && slices.ContainsFunc(s.Fields, isPointer)
+	}
+
+	var (
+		structs []getterStruct
+		imports []gen.Import
+		usesOpt bool
+		usesPtr bool
+	)
+
+	for _, s := range pkg.Structs {
+		if !selected(s) {
+			continue
+		}
+
+		res := getterStruct{Struct: s}
+
+		for _, field := range s.Fields {
+			if !isPointer(field) {
+				continue
+			}
+
+			has := func(method string) bool {
+				return pkg.HasMethod(s.Name, method) || slices.ContainsFunc(s.Fields, func(f *gen.Field) bool {
+					return f.Name == method
+				})
+			}
+
+			elem, ok := pkg.Struct(field.Elem)
+			res.Fields = append(res.Fields, getterField{
+				Field: field,
+				Get:   !has("Get" + field.Name),
+				Ptr:   ok && selected(elem) && !has(field.Name+"Ptr"),
+				Opt:   !has(field.Name + "Opt"),
+				Has:   !has("Has" + field.Name),
+				Set:   !has("Set" + field.Name),
+			})
+
+			last := res.Fields[len(res.Fields)-1]
+			usesOpt = usesOpt || last.Opt
+			usesPtr = usesPtr || last.Set
+			imports = gen.AppendImports(imports, field.Imports...)
+		}
+
+		structs = append(structs, res)
+	}
+
+	if usesOpt {
+		imports = gen.AppendImports(imports, gen.Import{Path: "github.com/sr9000/go-ptr-tools/opt"})
+	}
+
+	if usesPtr {
+		imports = gen.AppendImports(imports, gen.Import{Path: "github.com/sr9000/go-ptr-tools/ptr"})
+	}
+
+	tmpl := template.Must(template.New("getters").Funcs(gen.FuncMap()).Parse(gettersRaw))
+
+	return gen.Render(generatorName, pkg.Name, imports, tmpl, struct{ Structs []getterStruct }{structs})
+}
+
+func isPointer(field *gen.Field) bool {
+	return field.Elem != ""
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const fixtureDir = "internal/fixture"
+
+func TestRenderGolden(t *testing.T) {
+	t.Parallel()
+
+	actual, err := render(fixtureDir, defaultOutput, nil)
+	require.NoError(t, err)
+
+	expected, err := os.ReadFile(filepath.Join(fixtureDir, defaultOutput))
+	require.NoError(t, err)
+	require.Equal(t, string(expected), string(actual), "run go generate ./cmd/ptrgetters/... to update the fixture")
+}
+
+func TestRenderTypes(t *testing.T) {
+	t.Parallel()
+
+	actual, err := render(fixtureDir, defaultOutput, []string{"Account"})
+	require.NoError(t, err)
+	require.Contains(t, string(actual), "func (a *Account) GetName() string")
+	require.Contains(t, string(actual), "func (a *Account) GetProfile() Profile")
+	require.NotContains(t, string(actual), "ProfilePtr", "Profile has no accessors")
+	require.NotContains(t, string(actual), "HasAdmin", "declared by hand")
+	require.NotContains(t, string(actual), "Request")
+
+	_, err = render(fixtureDir, defaultOutput, []string{"Unknown"})
+	require.ErrorIs(t, err, errUnknownType)
+
+	_, err = render("internal", defaultOutput, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,45 @@
+// Package fixture holds structs for golden tests of ptrgetters, ptr_getters.go is generated from them.
+package fixture
+
+import (
+	"math/rand/v2"
+	"time"
+
+	"github.com/sr9000/go-ptr-tools/opt"
+)
+
+//go:generate go run ../..
+
+type Request struct {
+	ID      int
+	User    *Account
+	Timeout *time.Duration
+	Trace   opt.Opt[string]
+	Seed    *rand.PCG // the package name differs from the last element of the import path
+}
+
+type Account struct {
+	Name    *string
+	Profile *Profile
+	Admin   *bool
+	secret  *string
+}
+
+// HasAdmin is declared by hand, it is not generated.
+func (a *Account) HasAdmin() bool {
+	return a != nil && a.Admin != nil && *a.Admin
+}
+
+type Profile struct {
+	Age   *int
+	Email *string
+}
+
+type Page[T any] struct {
+	Items []T
+	Next  *T
+}
+
+type Plain struct {
+	Name string
+}
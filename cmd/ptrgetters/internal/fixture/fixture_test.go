@@ -0,0 +1,42 @@
+package fixture_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sr9000/go-ptr-tools/cmd/ptrgetters/internal/fixture"
+	"github.com/sr9000/go-ptr-tools/opt"
+	"github.com/sr9000/go-ptr-tools/ptr"
+)
+
+func TestGetters(t *testing.T) {
+	t.Parallel()
+
+	var req *fixture.Request
+
+	require.Empty(t, req.UserPtr().ProfilePtr().GetEmail())
+	require.Zero(t, req.GetTimeout())
+	require.False(t, req.HasUser())
+	require.True(t, req.TimeoutOpt().IsMissing())
+
+	req = &fixture.Request{User: &fixture.Account{}}
+	require.True(t, req.HasUser())
+	require.Equal(t, fixture.Account{}, req.GetUser())
+	require.False(t, req.UserPtr().HasProfile())
+	require.Empty(t, req.UserPtr().ProfilePtr().GetEmail())
+
+	req.UserPtr().SetProfile(fixture.Profile{Email: ptr.Of("bob@example.com")})
+	req.SetTimeout(time.Second)
+
+	require.Equal(t, "bob@example.com", req.UserPtr().ProfilePtr().GetEmail())
+	require.Equal(t, opt.Of("bob@example.com"), req.UserPtr().ProfilePtr().EmailOpt())
+	require.Equal(t, time.Second, req.GetTimeout())
+	require.Equal(t, fixture.Profile{Email: ptr.Of("bob@example.com")}, req.UserPtr().GetProfile())
+
+	page := &fixture.Page[int]{}
+	require.Zero(t, page.GetNext())
+	page.SetNext(2)
+	require.Equal(t, opt.Of(2), page.NextOpt())
+}
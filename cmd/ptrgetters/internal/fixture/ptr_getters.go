@@ -0,0 +1,296 @@
+// Code generated by ptrgetters; DO NOT EDIT.
+
+package fixture
+
+import (
+	"math/rand/v2"
+	"time"
+
+	"github.com/sr9000/go-ptr-tools/opt"
+	"github.com/sr9000/go-ptr-tools/ptr"
+)
+
+// GetUser returns the value of User, or the zero value if r or User is nil.
+func (r *Request) GetUser() Account {
+	if r == nil || r.User == nil {
+		var zero Account
+
+		return zero
+	}
+
+	return *r.User
+}
+
+// UserPtr returns User, or nil if r is nil.
+// Accessors of Account are nil-safe too, so calls can be chained.
+func (r *Request) UserPtr() *Account {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// UserOpt returns the value of User as opt.Opt, it is missing if r or User is nil.
+func (r *Request) UserOpt() opt.Opt[Account] {
+	if r == nil {
+		return opt.Opt[Account]{}
+	}
+
+	return opt.FromPtr(r.User)
+}
+
+// HasUser reports whether r and User are not nil.
+func (r *Request) HasUser() bool {
+	return r != nil && r.User != nil
+}
+
+// SetUser sets User to a pointer to a copy of val.
+func (r *Request) SetUser(val Account) {
+	r.User = ptr.Of(val)
+}
+
+// GetTimeout returns the value of Timeout, or the zero value if r or Timeout is nil.
+func (r *Request) GetTimeout() time.Duration {
+	if r == nil || r.Timeout == nil {
+		var zero time.Duration
+
+		return zero
+	}
+
+	return *r.Timeout
+}
+
+// TimeoutOpt returns the value of Timeout as opt.Opt, it is missing if r or Timeout is nil.
+func (r *Request) TimeoutOpt() opt.Opt[time.Duration] {
+	if r == nil {
+		return opt.Opt[time.Duration]{}
+	}
+
+	return opt.FromPtr(r.Timeout)
+}
+
+// HasTimeout reports whether r and Timeout are not nil.
+func (r *Request) HasTimeout() bool {
+	return r != nil && r.Timeout != nil
+}
+
+// SetTimeout sets Timeout to a pointer to a copy of val.
+func (r *Request) SetTimeout(val time.Duration) {
+	r.Timeout = ptr.Of(val)
+}
+
+// GetSeed returns the value of Seed, or the zero value if r or Seed is nil.
+func (r *Request) GetSeed() rand.PCG {
+	if r == nil || r.Seed == nil {
+		var zero rand.PCG
+
+		return zero
+	}
+
+	return *r.Seed
+}
+
+// SeedOpt returns the value of Seed as opt.Opt, it is missing if r or Seed is nil.
+func (r *Request) SeedOpt() opt.Opt[rand.PCG] {
+	if r == nil {
+		return opt.Opt[rand.PCG]{}
+	}
+
+	return opt.FromPtr(r.Seed)
+}
+
+// HasSeed reports whether r and Seed are not nil.
+func (r *Request) HasSeed() bool {
+	return r != nil && r.Seed != nil
+}
+
+// SetSeed sets Seed to a pointer to a copy of val.
+func (r *Request) SetSeed(val rand.PCG) {
+	r.Seed = ptr.Of(val)
+}
+
+// GetName returns the value of Name, or the zero value if a or Name is nil.
+func (a *Account) GetName() string {
+	if a == nil || a.Name == nil {
+		var zero string
+
+		return zero
+	}
+
+	return *a.Name
+}
+
+// NameOpt returns the value of Name as opt.Opt, it is missing if a or Name is nil.
+func (a *Account) NameOpt() opt.Opt[string] {
+	if a == nil {
+		return opt.Opt[string]{}
+	}
+
+	return opt.FromPtr(a.Name)
+}
+
+// HasName reports whether a and Name are not nil.
+func (a *Account) HasName() bool {
+	return a != nil && a.Name != nil
+}
+
+// SetName sets Name to a pointer to a copy of val.
+func (a *Account) SetName(val string) {
+	a.Name = ptr.Of(val)
+}
+
+// GetProfile returns the value of Profile, or the zero value if a or Profile is nil.
+func (a *Account) GetProfile() Profile {
+	if a == nil || a.Profile == nil {
+		var zero Profile
+
+		return zero
+	}
+
+	return *a.Profile
+}
+
+// ProfilePtr returns Profile, or nil if a is nil.
+// Accessors of Profile are nil-safe too, so calls can be chained.
+func (a *Account) ProfilePtr() *Profile {
+	if a == nil {
+		return nil
+	}
+
+	return a.Profile
+}
+
+// ProfileOpt returns the value of Profile as opt.Opt, it is missing if a or Profile is nil.
+func (a *Account) ProfileOpt() opt.Opt[Profile] {
+	if a == nil {
+		return opt.Opt[Profile]{}
+	}
+
+	return opt.FromPtr(a.Profile)
+}
+
+// HasProfile reports whether a and Profile are not nil.
+func (a *Account) HasProfile() bool {
+	return a != nil && a.Profile != nil
+}
+
+// SetProfile sets Profile to a pointer to a copy of val.
+func (a *Account) SetProfile(val Profile) {
+	a.Profile = ptr.Of(val)
+}
+
+// GetAdmin returns the value of Admin, or the zero value if a or Admin is nil.
+func (a *Account) GetAdmin() bool {
+	if a == nil || a.Admin == nil {
+		var zero bool
+
+		return zero
+	}
+
+	return *a.Admin
+}
+
+// AdminOpt returns the value of Admin as opt.Opt, it is missing if a or Admin is nil.
+func (a *Account) AdminOpt() opt.Opt[bool] {
+	if a == nil {
+		return opt.Opt[bool]{}
+	}
+
+	return opt.FromPtr(a.Admin)
+}
+
+// SetAdmin sets Admin to a pointer to a copy of val.
+func (a *Account) SetAdmin(val bool) {
+	a.Admin = ptr.Of(val)
+}
+
+// GetAge returns the value of Age, or the zero value if p or Age is nil.
+func (p *Profile) GetAge() int {
+	if p == nil || p.Age == nil {
+		var zero int
+
+		return zero
+	}
+
+	return *p.Age
+}
+
+// AgeOpt returns the value of Age as opt.Opt, it is missing if p or Age is nil.
+func (p *Profile) AgeOpt() opt.Opt[int] {
+	if p == nil {
+		return opt.Opt[int]{}
+	}
+
+	return opt.FromPtr(p.Age)
+}
+
+// HasAge reports whether p and Age are not nil.
+func (p *Profile) HasAge() bool {
+	return p != nil && p.Age != nil
+}
+
+// SetAge sets Age to a pointer to a copy of val.
+func (p *Profile) SetAge(val int) {
+	p.Age = ptr.Of(val)
+}
+
+// GetEmail returns the value of Email, or the zero value if p or Email is nil.
+func (p *Profile) GetEmail() string {
+	if p == nil || p.Email == nil {
+		var zero string
+
+		return zero
+	}
+
+	return *p.Email
+}
+
+// EmailOpt returns the value of Email as opt.Opt, it is missing if p or Email is nil.
+func (p *Profile) EmailOpt() opt.Opt[string] {
+	if p == nil {
+		return opt.Opt[string]{}
+	}
+
+	return opt.FromPtr(p.Email)
+}
+
+// HasEmail reports whether p and Email are not nil.
+func (p *Profile) HasEmail() bool {
+	return p != nil && p.Email != nil
+}
+
+// SetEmail sets Email to a pointer to a copy of val.
+func (p *Profile) SetEmail(val string) {
+	p.Email = ptr.Of(val)
+}
+
+// GetNext returns the value of Next, or the zero value if p or Next is nil.
+func (p *Page[T]) GetNext() T {
+	if p == nil || p.Next == nil {
+		var zero T
+
+		return zero
+	}
+
+	return *p.Next
+}
+
+// NextOpt returns the value of Next as opt.Opt, it is missing if p or Next is nil.
+func (p *Page[T]) NextOpt() opt.Opt[T] {
+	if p == nil {
+		return opt.Opt[T]{}
+	}
+
+	return opt.FromPtr(p.Next)
+}
+
+// HasNext reports whether p and Next are not nil.
+func (p *Page[T]) HasNext() bool {
+	return p != nil && p.Next != nil
+}
+
+// SetNext sets Next to a pointer to a copy of val.
+func (p *Page[T]) SetNext(val T) {
+	p.Next = ptr.Of(val)
+}
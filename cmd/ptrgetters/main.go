@@ -0,0 +1,58 @@
+// Command ptrgetters generates nil-safe accessor methods for pointer fields of structs, like protobuf getters.
+//
+// For each exported *T field X of a struct S it generates methods on *S:
+//   - GetX() T returns the value, or the zero value if the receiver or the field is nil.
+//   - XPtr() *T returns the pointer, or nil if the receiver is nil. It is generated only if T is a struct
+//     of the same package getting accessors too, so calls can be chained: req.UserPtr().ProfilePtr().GetName().
+//   - XOpt() opt.Opt[T] returns the value as opt.Opt.
+//   - HasX() bool reports whether the receiver and the field are not nil.
+//   - SetX(T) sets the field to a pointer to a copy of the value.
+//
+// Methods already declared (or clashing with field names) are skipped. Usage:
+//
+//	//go:generate go run github.com/sr9000/go-ptr-tools/cmd/ptrgetters [-type A,B] [-output ptr_getters.go] [dir]
+//
+// All structs with pointer fields of the package in dir (the current directory by default) are processed
+// unless -type is set.
+package main
+
+import (
+	"flag"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const defaultOutput = "ptr_getters.go"
+
+func main() {
+	typeNames := flag.String("type", "", "comma-separated list of struct names, all structs if empty")
+	output := flag.String("output", defaultOutput, "output file name, relative to the package directory")
+	flag.Parse()
+
+	dir := "."
+	if flag.NArg() > 0 {
+		dir = flag.Arg(0)
+	}
+
+	var types []string
+	if *typeNames != "" {
+		types = strings.Split(*typeNames, ",")
+	}
+
+	err := generate(dir, *output, types)
+	if err != nil {
+		slog.Error("ptrgetters", slog.Any("err", err))
+		os.Exit(1)
+	}
+}
+
+func generate(dir, output string, types []string) error {
+	src, err := render(dir, output, types)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, output), src, ownerWritePermission)
+}
@@ -0,0 +1,20 @@
+// Command ptrnilness runs the ptrnilness analyzer reporting dereferences of possibly nil ptr and opt results.
+//
+// Run it standalone or as a vet tool:
+//
+//	go install github.com/sr9000/go-ptr-tools/cmd/ptrnilness
+//	ptrnilness ./...
+//	go vet -vettool=$(which ptrnilness) ./...
+//
+// See the package github.com/sr9000/go-ptr-tools/analysis/ptrnilness for the reported issues.
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/singlechecker"
+
+	"github.com/sr9000/go-ptr-tools/analysis/ptrnilness"
+)
+
+func main() {
+	singlechecker.Main(ptrnilness.Analyzer)
+}
@@ -0,0 +1,31 @@
+// Command plugin is the golangci-lint plugin of the ptrnilness analyzer.
+//
+// Build it with the same versions of Go and dependencies as golangci-lint itself:
+//
+//	go build -buildmode=plugin -o ptrnilness.so github.com/sr9000/go-ptr-tools/cmd/ptrnilness/plugin
+//
+// Then register it in .golangci.yaml:
+//
+//	linters:
+//	  enable:
+//	    - ptrnilness
+//	  settings:
+//	    custom:
+//	      ptrnilness:
+//	        path: ptrnilness.so
+//	        description: reports dereferences of possibly nil ptr and opt results
+package main
+
+import (
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/sr9000/go-ptr-tools/analysis/ptrnilness"
+)
+
+// New is looked up by golangci-lint when loading the plugin, the analyzer has no settings.
+func New(any) ([]*analysis.Analyzer, error) {
+	return []*analysis.Analyzer{ptrnilness.Analyzer}, nil
+}
+
+// main is never called, plugins are loaded by golangci-lint.
+func main() {}
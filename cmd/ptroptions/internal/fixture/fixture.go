@@ -0,0 +1,39 @@
+// Package fixture holds structs for golden tests of ptroptions, ptr_options.go is generated from them.
+package fixture
+
+import (
+	"net/url"
+	"time"
+)
+
+//go:generate go run ../..
+
+// Config is a client config.
+//
+//ptrtools:options
+type Config struct {
+	Timeout *time.Duration
+	Retries *int
+	Proxy   *url.URL
+	Name    string
+}
+
+//ptrtools:options type=CacheOpt values=CacheSettings prefix=Cache
+type Cache[K comparable] struct {
+	Size *int
+	Keys []K
+}
+
+// WithRetries is declared by hand, it is not generated.
+func WithRetries(retries int) ConfigOption {
+	return func(c *Config) {
+		if retries > 0 {
+			c.Retries = &retries
+		}
+	}
+}
+
+// NotAnnotated gets no options.
+type NotAnnotated struct {
+	Value *int
+}
@@ -0,0 +1,37 @@
+package fixture_test
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sr9000/go-ptr-tools/cmd/ptroptions/internal/fixture"
+	"github.com/sr9000/go-ptr-tools/ptr"
+)
+
+func TestOptions(t *testing.T) {
+	t.Parallel()
+
+	defaults := fixture.ConfigValues{Timeout: time.Second, Retries: 3, Name: "default"}
+
+	cfg := new(fixture.Config).Apply(
+		fixture.WithTimeout(time.Minute),
+		fixture.WithRetries(0), // hand-written option ignores non-positive values
+		fixture.WithProxy(url.URL{Host: "proxy"}),
+	)
+
+	require.Equal(t, &fixture.Config{Timeout: ptr.Of(time.Minute), Proxy: &url.URL{Host: "proxy"}}, cfg)
+	require.Equal(t, fixture.ConfigValues{
+		Timeout: time.Minute,
+		Retries: 3,
+		Proxy:   url.URL{Host: "proxy"},
+	}, cfg.Resolve(defaults))
+
+	var none *fixture.Config
+	require.Equal(t, defaults, none.Resolve(defaults))
+
+	cache := new(fixture.Cache[string]).Apply(fixture.CacheSize[string](10))
+	require.Equal(t, fixture.CacheSettings[string]{Size: 10}, cache.Resolve(fixture.CacheSettings[string]{}))
+}
@@ -0,0 +1,96 @@
+// Code generated by ptroptions; DO NOT EDIT.
+
+package fixture
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/sr9000/go-ptr-tools/ptr"
+	"github.com/sr9000/go-ptr-tools/ref"
+)
+
+// ConfigOption configures Config, see Config.Apply.
+type ConfigOption func(*Config)
+
+// WithTimeout sets Timeout to a pointer to a copy of val.
+func WithTimeout(val time.Duration) ConfigOption {
+	return func(c *Config) {
+		c.Timeout = ptr.Of(val)
+	}
+}
+
+// WithProxy sets Proxy to a pointer to a copy of val.
+func WithProxy(val url.URL) ConfigOption {
+	return func(c *Config) {
+		c.Proxy = ptr.Of(val)
+	}
+}
+
+// Apply applies options to c in order and returns it.
+func (c *Config) Apply(opts ...ConfigOption) *Config {
+	for _, option := range opts {
+		option(c)
+	}
+
+	return c
+}
+
+// ConfigValues is Config with all optional fields resolved, see Config.Resolve.
+type ConfigValues struct {
+	Timeout time.Duration
+	Retries int
+	Proxy   url.URL
+	Name    string
+}
+
+// Resolve returns values of c, nil pointer fields (or all of them, if c is nil) are taken from defaults.
+func (c *Config) Resolve(defaults ConfigValues) ConfigValues {
+	if c == nil {
+		return defaults
+	}
+
+	return ConfigValues{
+		Timeout: ptr.Else(ref.Of(defaults.Timeout), c.Timeout).Val(),
+		Retries: ptr.Else(ref.Of(defaults.Retries), c.Retries).Val(),
+		Proxy:   ptr.Else(ref.Of(defaults.Proxy), c.Proxy).Val(),
+		Name:    c.Name,
+	}
+}
+
+// CacheOpt configures Cache, see Cache.Apply.
+type CacheOpt[K comparable] func(*Cache[K])
+
+// CacheSize sets Size to a pointer to a copy of val.
+func CacheSize[K comparable](val int) CacheOpt[K] {
+	return func(c *Cache[K]) {
+		c.Size = ptr.Of(val)
+	}
+}
+
+// Apply applies options to c in order and returns it.
+func (c *Cache[K]) Apply(opts ...CacheOpt[K]) *Cache[K] {
+	for _, option := range opts {
+		option(c)
+	}
+
+	return c
+}
+
+// CacheSettings is Cache with all optional fields resolved, see Cache.Resolve.
+type CacheSettings[K comparable] struct {
+	Size int
+	Keys []K
+}
+
+// Resolve returns values of c, nil pointer fields (or all of them, if c is nil) are taken from defaults.
+func (c *Cache[K]) Resolve(defaults CacheSettings[K]) CacheSettings[K] {
+	if c == nil {
+		return defaults
+	}
+
+	return CacheSettings[K]{
+		Size: ptr.Else(ref.Of(defaults.Size), c.Size).Val(),
+		Keys: c.Keys,
+	}
+}
@@ -0,0 +1,55 @@
+// Command ptroptions generates functional options for structs with optional *T fields.
+//
+// Annotate the struct with the directive comment:
+//
+//	//ptrtools:options [type=ConfigOption] [values=ConfigValues] [prefix=With]
+//	type Config struct {
+//		Timeout *time.Duration
+//		Name    string
+//	}
+//
+// For the struct S it generates:
+//   - the option type `type SOption func(*S)` (named by the type argument),
+//   - `WithX(T) SOption` for each exported *T field X, setting it with ptr.Of (the prefix argument renames With),
+//   - the `(*S).Apply(...SOption) *S` method applying options in order,
+//   - the value struct `SValues` (named by the values argument) with *T fields replaced by T,
+//   - the `(*S).Resolve(defaults SValues) SValues` method taking nil fields from defaults with ptr.Else.
+//
+// Functions and methods already declared are skipped. Usage:
+//
+//	//go:generate go run github.com/sr9000/go-ptr-tools/cmd/ptroptions [-output ptr_options.go] [dir]
+package main
+
+import (
+	"flag"
+	"log/slog"
+	"os"
+	"path/filepath"
+)
+
+const defaultOutput = "ptr_options.go"
+
+func main() {
+	output := flag.String("output", defaultOutput, "output file name, relative to the package directory")
+	flag.Parse()
+
+	dir := "."
+	if flag.NArg() > 0 {
+		dir = flag.Arg(0)
+	}
+
+	err := generate(dir, *output)
+	if err != nil {
+		slog.Error("ptroptions", slog.Any("err", err))
+		os.Exit(1)
+	}
+}
+
+func generate(dir, output string) error {
+	src, err := render(dir, output)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, output), src, ownerWritePermission)
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	_ "embed"
+
+	"errors"
+	"fmt"
+	"text/template"
+
+	"github.com/sr9000/go-ptr-tools/internal/gen"
+)
+
+const (
+	generatorName        = "ptroptions"
+	directive            = "options"
+	ownerWritePermission = 0o644
+)
+
+var (
+	//go:embed tmpl/options.gotmpl
+	optionsRaw string
+
+	errNoStructs = errors.New("no structs annotated with //ptrtools:" + directive)
+)
+
+type optionsStruct struct {
+	*gen.Struct
+
+	OptionType, ValuesType, Prefix string
+	Apply, Resolve                 bool // whether to generate the method
+
+	Fields []optionsField
+}
+
+type optionsField struct {
+	*gen.Field
+
+	With bool // whether to generate the option
+}
+
+// render generates the code of options for annotated structs of the package in dir.
+func render(dir, output string) ([]byte, error) {
+	pkg, err := gen.Load(dir, func(filename string) bool { return filename == output })
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		structs          []optionsStruct
+		imports          []gen.Import
+		usesPtr, usesRef bool
+	)
+
+	for _, s := range pkg.Structs {
+		args, ok := s.Directive(directive)
+		if !ok {
+			continue
+		}
+
+		res := optionsStruct{
+			Struct:     s,
+			OptionType: argOr(args, "type", s.Name+"Option"),
+			ValuesType: argOr(args, "values", s.Name+"Values"),
+			Prefix:     argOr(args, "prefix", "With"),
+			Apply:      !pkg.HasMethod(s.Name, "Apply"),
+			Resolve:    !pkg.HasMethod(s.Name, "Resolve"),
+		}
+
+		for _, field := range s.Fields {
+			with := field.Elem != "" && !pkg.HasFunc(res.Prefix+field.Name)
+			resolved := field.Elem != "" && res.Resolve
+
+			res.Fields = append(res.Fields, optionsField{Field: field, With: with})
+			usesPtr = usesPtr || with || resolved
+			usesRef = usesRef || resolved
+		}
+
+		imports = gen.AppendImports(imports, s.Imports()...)
+		structs = append(structs, res)
+	}
+
+	if len(structs) == 0 {
+		return nil, fmt.Errorf("%w in %s", errNoStructs, dir)
+	}
+
+	if usesPtr {
+		imports = gen.AppendImports(imports, gen.Import{Path: "github.com/sr9000/go-ptr-tools/ptr"})
+	}
+
+	if usesRef {
+		imports = gen.AppendImports(imports, gen.Import{Path: "github.com/sr9000/go-ptr-tools/ref"})
+	}
+
+	tmpl := template.Must(template.New("options").Funcs(gen.FuncMap()).Parse(optionsRaw))
+
+	return gen.Render(generatorName, pkg.Name, imports, tmpl, struct{ Structs []optionsStruct }{structs})
+}
+
+func argOr(args map[string]string, key, fallback string) string {
+	if val := args[key]; val != "" {
+		return val
+	}
+
+	return fallback
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const fixtureDir = "internal/fixture"
+
+func TestRenderGolden(t *testing.T) {
+	t.Parallel()
+
+	actual, err := render(fixtureDir, defaultOutput)
+	require.NoError(t, err)
+
+	expected, err := os.ReadFile(filepath.Join(fixtureDir, defaultOutput))
+	require.NoError(t, err)
+	require.Equal(t, string(expected), string(actual), "run go generate ./cmd/ptroptions/... to update the fixture")
+}
+
+func TestRenderNoStructs(t *testing.T) {
+	t.Parallel()
+
+	_, err := render(".", defaultOutput)
+	require.ErrorIs(t, err, errNoStructs)
+}
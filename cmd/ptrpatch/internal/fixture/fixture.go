@@ -0,0 +1,27 @@
+// Package fixture holds structs for golden tests of ptrpatch, ptr_patch.go is generated from them.
+package fixture
+
+import "time"
+
+//go:generate go run ../..
+
+// User is patched with pointers.
+//
+//ptrtools:patch
+type User struct {
+	Name     string    `json:"name"`
+	Email    *string   `json:"email,omitempty"`
+	Tags     []string  `json:"tags"`
+	Birthday time.Time `json:"birthday"`
+	Hash     []byte    `patch:"-"`
+	Session  string    `json:"-"`
+	Labels   map[string]string
+}
+
+//ptrtools:patch style=opt name=AccountChanges
+type Account[ID comparable] struct {
+	ID      ID             `json:"id"`
+	Balance int64          `json:"balance"`
+	Limit   *int64         `json:"limit"`
+	Expires *time.Duration `json:"expires"`
+}
@@ -0,0 +1,85 @@
+package fixture_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sr9000/go-ptr-tools/cmd/ptrpatch/internal/fixture"
+	"github.com/sr9000/go-ptr-tools/opt"
+	"github.com/sr9000/go-ptr-tools/ptr"
+)
+
+func TestUserPatch(t *testing.T) {
+	t.Parallel()
+
+	before := fixture.User{Name: "bob", Tags: []string{"a"}, Hash: []byte("x")}
+	after := fixture.User{Name: "bob", Email: ptr.Of("bob@example.com"), Tags: []string{"a", "b"}, Hash: []byte("y")}
+
+	patch := fixture.DiffUser(before, after)
+	require.Equal(t, []string{"Email", "Tags"}, patch.Changed())
+
+	raw, err := json.Marshal(patch)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"email": "bob@example.com", "tags": ["a", "b"]}`, string(raw))
+
+	var decoded fixture.UserPatch
+	require.NoError(t, json.Unmarshal(raw, &decoded))
+
+	decoded.ApplyTo(&before)
+	require.Equal(t, fixture.User{
+		Name:  "bob",
+		Email: ptr.Of("bob@example.com"),
+		Tags:  []string{"a", "b"},
+		Hash:  []byte("x"), // ignored by the patch
+	}, before)
+	require.NotSame(t, patch.Email, before.Email)
+
+	require.Empty(t, fixture.DiffUser(after, fixture.User{Name: "bob", Tags: []string{"a", "b"}}).Changed(),
+		"reset to nil is not expressible")
+}
+
+func TestAccountChanges(t *testing.T) {
+	t.Parallel()
+
+	before := fixture.Account[string]{ID: "a", Balance: 10, Limit: ptr.Of[int64](100)}
+	after := fixture.Account[string]{ID: "a", Balance: 20, Expires: ptr.Of(time.Hour)}
+
+	patch := fixture.DiffAccount(before, after)
+	require.Equal(t, []string{"Balance", "Limit", "Expires"}, patch.Changed())
+	require.Equal(t, opt.Of[*int64](nil), patch.Limit)
+
+	raw, err := json.Marshal(patch)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"balance": 20, "limit": null, "expires": 3600000000000}`, string(raw))
+
+	var decoded fixture.AccountChanges[string]
+	require.NoError(t, json.Unmarshal(raw, &decoded))
+	require.Equal(t, patch, decoded)
+
+	decoded.ApplyTo(&before)
+	require.Equal(t, after, before)
+
+	require.Error(t, json.Unmarshal([]byte(`{"balance": "x"}`), &decoded))
+}
+
+func TestAccountChangesCopy(t *testing.T) {
+	t.Parallel()
+
+	after := fixture.Account[string]{Limit: ptr.Of[int64](100)}
+
+	patch := fixture.DiffAccount(fixture.Account[string]{}, after)
+	limit, ok := patch.Limit.Get()
+	require.True(t, ok)
+	require.NotSame(t, after.Limit, limit)
+
+	var dst fixture.Account[string]
+	patch.ApplyTo(&dst)
+	require.NotSame(t, limit, dst.Limit)
+
+	*limit = 200
+	require.Equal(t, ptr.Of[int64](100), dst.Limit)
+	require.Equal(t, ptr.Of[int64](100), after.Limit)
+}
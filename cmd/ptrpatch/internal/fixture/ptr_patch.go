@@ -0,0 +1,282 @@
+// Code generated by ptrpatch; DO NOT EDIT.
+
+package fixture
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/sr9000/go-ptr-tools/opt"
+	"github.com/sr9000/go-ptr-tools/ptr"
+)
+
+// UserPatch is a partial update of User, absent fields are left untouched by ApplyTo.
+type UserPatch struct {
+	Name     *string            `json:"name,omitempty"`
+	Email    *string            `json:"email,omitempty"`
+	Tags     *[]string          `json:"tags,omitempty"`
+	Birthday *time.Time         `json:"birthday,omitempty"`
+	Session  *string            `json:"-"`
+	Labels   *map[string]string `json:"Labels,omitempty"`
+}
+
+// ApplyTo sets fields of dst present in the patch.
+func (p UserPatch) ApplyTo(dst *User) {
+	if p.Name != nil {
+		dst.Name = *p.Name
+	}
+
+	if p.Email != nil {
+		dst.Email = ptr.Of(*p.Email)
+	}
+
+	if p.Tags != nil {
+		dst.Tags = *p.Tags
+	}
+
+	if p.Birthday != nil {
+		dst.Birthday = *p.Birthday
+	}
+
+	if p.Session != nil {
+		dst.Session = *p.Session
+	}
+
+	if p.Labels != nil {
+		dst.Labels = *p.Labels
+	}
+}
+
+// Changed returns names of fields present in the patch.
+func (p UserPatch) Changed() []string {
+	var changed []string
+
+	if p.Name != nil {
+		changed = append(changed, "Name")
+	}
+
+	if p.Email != nil {
+		changed = append(changed, "Email")
+	}
+
+	if p.Tags != nil {
+		changed = append(changed, "Tags")
+	}
+
+	if p.Birthday != nil {
+		changed = append(changed, "Birthday")
+	}
+
+	if p.Session != nil {
+		changed = append(changed, "Session")
+	}
+
+	if p.Labels != nil {
+		changed = append(changed, "Labels")
+	}
+
+	return changed
+}
+
+// DiffUser returns the patch turning before into after, fields are compared with ptr.DeepEqual.
+// Changes of pointer fields to nil cannot be expressed and are skipped.
+func DiffUser(before, after User) UserPatch {
+	var patch UserPatch
+
+	if !ptr.DeepEqual(before.Name, after.Name) {
+		patch.Name = ptr.Of(after.Name)
+	}
+
+	if !ptr.DeepEqual(before.Email, after.Email) && after.Email != nil {
+		patch.Email = ptr.Of(*after.Email)
+	}
+
+	if !ptr.DeepEqual(before.Tags, after.Tags) {
+		patch.Tags = ptr.Of(after.Tags)
+	}
+
+	if !ptr.DeepEqual(before.Birthday, after.Birthday) {
+		patch.Birthday = ptr.Of(after.Birthday)
+	}
+
+	if !ptr.DeepEqual(before.Session, after.Session) {
+		patch.Session = ptr.Of(after.Session)
+	}
+
+	if !ptr.DeepEqual(before.Labels, after.Labels) {
+		patch.Labels = ptr.Of(after.Labels)
+	}
+
+	return patch
+}
+
+// AccountChanges is a partial update of Account, absent fields are left untouched by ApplyTo.
+type AccountChanges[ID comparable] struct {
+	ID      opt.Opt[ID]             `json:"id,omitzero"`
+	Balance opt.Opt[int64]          `json:"balance,omitzero"`
+	Limit   opt.Opt[*int64]         `json:"limit,omitzero"`
+	Expires opt.Opt[*time.Duration] `json:"expires,omitzero"`
+}
+
+// ApplyTo sets fields of dst present in the patch.
+func (p AccountChanges[ID]) ApplyTo(dst *Account[ID]) {
+	if val, ok := p.ID.Get(); ok {
+		dst.ID = val
+	}
+
+	if val, ok := p.Balance.Get(); ok {
+		dst.Balance = val
+	}
+
+	if val, ok := p.Limit.Get(); ok {
+		if val != nil {
+			val = ptr.Of(*val)
+		}
+
+		dst.Limit = val
+	}
+
+	if val, ok := p.Expires.Get(); ok {
+		if val != nil {
+			val = ptr.Of(*val)
+		}
+
+		dst.Expires = val
+	}
+}
+
+// Changed returns names of fields present in the patch.
+func (p AccountChanges[ID]) Changed() []string {
+	var changed []string
+
+	if p.ID.IsPresent() {
+		changed = append(changed, "ID")
+	}
+
+	if p.Balance.IsPresent() {
+		changed = append(changed, "Balance")
+	}
+
+	if p.Limit.IsPresent() {
+		changed = append(changed, "Limit")
+	}
+
+	if p.Expires.IsPresent() {
+		changed = append(changed, "Expires")
+	}
+
+	return changed
+}
+
+// DiffAccount returns the patch turning before into after, fields are compared with ptr.DeepEqual.
+func DiffAccount[ID comparable](before, after Account[ID]) AccountChanges[ID] {
+	var patch AccountChanges[ID]
+
+	if !ptr.DeepEqual(before.ID, after.ID) {
+		patch.ID = opt.Of(after.ID)
+	}
+
+	if !ptr.DeepEqual(before.Balance, after.Balance) {
+		patch.Balance = opt.Of(after.Balance)
+	}
+
+	if !ptr.DeepEqual(before.Limit, after.Limit) {
+		val := after.Limit
+		if val != nil {
+			val = ptr.Of(*val)
+		}
+
+		patch.Limit = opt.Of(val)
+	}
+
+	if !ptr.DeepEqual(before.Expires, after.Expires) {
+		val := after.Expires
+		if val != nil {
+			val = ptr.Of(*val)
+		}
+
+		patch.Expires = opt.Of(val)
+	}
+
+	return patch
+}
+
+// MarshalJSON implements json.Marshaler, absent fields are omitted.
+func (p AccountChanges[ID]) MarshalJSON() ([]byte, error) {
+	fields := make(map[string]any, 4)
+
+	if val, ok := p.ID.Get(); ok {
+		fields["id"] = val
+	}
+
+	if val, ok := p.Balance.Get(); ok {
+		fields["balance"] = val
+	}
+
+	if val, ok := p.Limit.Get(); ok {
+		fields["limit"] = val
+	}
+
+	if val, ok := p.Expires.Get(); ok {
+		fields["expires"] = val
+	}
+
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, fields absent in data stay absent, null values are present.
+func (p *AccountChanges[ID]) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+
+	if raw, ok := fields["id"]; ok {
+		var val ID
+
+		err = json.Unmarshal(raw, &val)
+		if err != nil {
+			return fmt.Errorf("id: %w", err)
+		}
+
+		p.ID = opt.Of(val)
+	}
+
+	if raw, ok := fields["balance"]; ok {
+		var val int64
+
+		err = json.Unmarshal(raw, &val)
+		if err != nil {
+			return fmt.Errorf("balance: %w", err)
+		}
+
+		p.Balance = opt.Of(val)
+	}
+
+	if raw, ok := fields["limit"]; ok {
+		var val *int64
+
+		err = json.Unmarshal(raw, &val)
+		if err != nil {
+			return fmt.Errorf("limit: %w", err)
+		}
+
+		p.Limit = opt.Of(val)
+	}
+
+	if raw, ok := fields["expires"]; ok {
+		var val *time.Duration
+
+		err = json.Unmarshal(raw, &val)
+		if err != nil {
+			return fmt.Errorf("expires: %w", err)
+		}
+
+		p.Expires = opt.Of(val)
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+// Command ptrpatch generates patch types (partial updates) for entity structs.
+//
+// Annotate the struct with the directive comment:
+//
+//	//ptrtools:patch [style=ptr|opt] [name=UserPatch]
+//	type User struct {
+//		Name  string  `json:"name"`
+//		Email *string `json:"email"`
+//		Hash  []byte  `patch:"-"`
+//	}
+//
+// For the struct S it generates the patch type SPatch (named by the name argument) with a field for each
+// exported field of S, except ones tagged with `patch:"-"`. The style argument defines patch field types:
+//   - ptr (default): T fields become *T tagged with omitempty, *T fields stay *T.
+//     Nil patch fields are absent, so *T fields of S cannot be reset to nil with a patch.
+//   - opt: T fields (including *T ones) become opt.Opt[T] tagged with omitzero.
+//     The patch gets MarshalJSON and UnmarshalJSON methods telling absent keys from nulls.
+//
+// JSON keys follow json tags of S. Pointees of *T fields are copied, so a patch and S never share them.
+// The generated methods and functions are:
+//   - SPatch.ApplyTo(*S) sets fields present in the patch,
+//   - SPatch.Changed() []string returns names of present fields,
+//   - DiffS(before, after S) SPatch returns the patch turning before into after.
+//
+// Usage:
+//
+//	//go:generate go run github.com/sr9000/go-ptr-tools/cmd/ptrpatch [-output ptr_patch.go] [dir]
+package main
+
+import (
+	"flag"
+	"log/slog"
+	"os"
+	"path/filepath"
+)
+
+const defaultOutput = "ptr_patch.go"
+
+func main() {
+	output := flag.String("output", defaultOutput, "output file name, relative to the package directory")
+	flag.Parse()
+
+	dir := "."
+	if flag.NArg() > 0 {
+		dir = flag.Arg(0)
+	}
+
+	err := generate(dir, *output)
+	if err != nil {
+		slog.Error("ptrpatch", slog.Any("err", err))
+		os.Exit(1)
+	}
+}
+
+func generate(dir, output string) error {
+	src, err := render(dir, output)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, output), src, ownerWritePermission)
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	_ "embed"
+
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/sr9000/go-ptr-tools/internal/gen"
+)
+
+const (
+	generatorName        = "ptrpatch"
+	directive            = "patch"
+	ownerWritePermission = 0o644
+
+	stylePtr = "ptr"
+	styleOpt = "opt"
+)
+
+var (
+	//go:embed tmpl/patch.gotmpl
+	patchRaw string
+
+	errNoStructs    = errors.New("no structs annotated with //ptrtools:" + directive)
+	errUnknownStyle = errors.New("unknown style")
+)
+
+type patchStruct struct {
+	*gen.Struct
+
+	PatchType   string
+	Opt         bool // opt.Opt fields instead of pointers
+	HasPointers bool
+
+	Fields []patchField
+}
+
+type patchField struct {
+	*gen.Field
+
+	Key            string // JSON key, empty if the field is not serialized
+	PatchFieldType string
+}
+
+// render generates the code of patches for annotated structs of the package in dir.
+func render(dir, output string) ([]byte, error) {
+	pkg, err := gen.Load(dir, func(filename string) bool { return filename == output })
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		structs []patchStruct
+		imports = []gen.Import{{Path: "github.com/sr9000/go-ptr-tools/ptr"}} // ptr.DeepEqual is always used
+	)
+
+	for _, s := range pkg.Structs {
+		args, ok := s.Directive(directive)
+		if !ok {
+			continue
+		}
+
+		style := args["style"]
+		if style != "" && style != stylePtr && style != styleOpt {
+			return nil, fmt.Errorf("%w %q of %s, expected %s or %s", errUnknownStyle, style, s.Name, stylePtr, styleOpt)
+		}
+
+		res := patchStruct{Struct: s, PatchType: s.Name + "Patch", Opt: style == styleOpt}
+		if name := args["name"]; name != "" {
+			res.PatchType = name
+		}
+
+		for _, field := range s.Fields {
+			if field.Tag.Get("patch") == "-" {
+				continue
+			}
+
+			res.Fields = append(res.Fields, newPatchField(field, res.Opt))
+			res.HasPointers = res.HasPointers || field.Elem != ""
+			imports = gen.AppendImports(imports, field.Imports...)
+		}
+
+		if res.Opt {
+			imports = gen.AppendImports(imports,
+				gen.Import{Path: "encoding/json"},
+				gen.Import{Path: "fmt"},
+				gen.Import{Path: "github.com/sr9000/go-ptr-tools/opt"},
+			)
+		}
+
+		structs = append(structs, res)
+	}
+
+	if len(structs) == 0 {
+		return nil, fmt.Errorf("%w in %s", errNoStructs, dir)
+	}
+
+	tmpl := template.Must(template.New("patch").Funcs(gen.FuncMap()).Parse(patchRaw))
+
+	return gen.Render(generatorName, pkg.Name, imports, tmpl, struct{ Structs []patchStruct }{structs})
+}
+
+func newPatchField(field *gen.Field, useOpt bool) patchField {
+	res := patchField{Field: field, Key: field.Name}
+
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+
+	switch {
+	case name == "-":
+		res.Key = ""
+	case name != "":
+		res.Key = name
+	}
+
+	switch {
+	case useOpt:
+		res.PatchFieldType = "opt.Opt[" + field.Type + "]"
+	case field.Elem != "":
+		res.PatchFieldType = field.Type
+	default:
+		res.PatchFieldType = "*" + field.Type
+	}
+
+	return res
+}
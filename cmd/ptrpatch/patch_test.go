@@ -0,0 +1,35 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const fixtureDir = "internal/fixture"
+
+func TestRenderGolden(t *testing.T) {
+	t.Parallel()
+
+	actual, err := render(fixtureDir, defaultOutput)
+	require.NoError(t, err)
+
+	expected, err := os.ReadFile(filepath.Join(fixtureDir, defaultOutput))
+	require.NoError(t, err)
+	require.Equal(t, string(expected), string(actual), "run go generate ./cmd/ptrpatch/... to update the fixture")
+}
+
+func TestRenderErr(t *testing.T) {
+	t.Parallel()
+
+	_, err := render(".", defaultOutput)
+	require.ErrorIs(t, err, errNoStructs)
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "x.go"), []byte("package x\n\n//ptrtools:patch style=ref\ntype X struct{}\n"), 0o600))
+
+	_, err = render(dir, defaultOutput)
+	require.ErrorIs(t, err, errUnknownStyle)
+}
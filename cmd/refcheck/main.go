@@ -0,0 +1,20 @@
+// Command refcheck runs the refcheck analyzer reporting ref.Ref values holding nil pointers.
+//
+// Run it standalone or as a vet tool:
+//
+//	go install github.com/sr9000/go-ptr-tools/cmd/refcheck
+//	refcheck ./...
+//	go vet -vettool=$(which refcheck) ./...
+//
+// See the package github.com/sr9000/go-ptr-tools/analysis/refcheck for the reported issues.
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/singlechecker"
+
+	"github.com/sr9000/go-ptr-tools/analysis/refcheck"
+)
+
+func main() {
+	singlechecker.Main(refcheck.Analyzer)
+}
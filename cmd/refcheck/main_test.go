@@ -0,0 +1,27 @@
+package main
+
+import (
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestVetTool runs the command as a vet tool, which analyzes the standard library dependencies for facts too.
+func TestVetTool(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("builds and runs go vet")
+	}
+
+	tool := filepath.Join(t.TempDir(), "refcheck")
+
+	out, err := exec.CommandContext(t.Context(), "go", "build", "-o", tool, ".").CombinedOutput()
+	require.NoError(t, err, string(out))
+
+	// maybe imports ref and depends on internal/poll, which buildssa fails to build
+	out, err = exec.CommandContext(t.Context(), "go", "vet", "-vettool="+tool, "../../maybe").CombinedOutput()
+	require.NoError(t, err, string(out))
+}
@@ -0,0 +1,31 @@
+// Command plugin is the golangci-lint plugin of the refcheck analyzer.
+//
+// Build it with the same versions of Go and dependencies as golangci-lint itself:
+//
+//	go build -buildmode=plugin -o refcheck.so github.com/sr9000/go-ptr-tools/cmd/refcheck/plugin
+//
+// Then register it in .golangci.yaml:
+//
+//	linters:
+//	  enable:
+//	    - refcheck
+//	  settings:
+//	    custom:
+//	      refcheck:
+//	        path: refcheck.so
+//	        description: reports ref.Ref values holding nil pointers
+package main
+
+import (
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/sr9000/go-ptr-tools/analysis/refcheck"
+)
+
+// New is looked up by golangci-lint when loading the plugin, the analyzer has no settings.
+func New(any) ([]*analysis.Analyzer, error) {
+	return []*analysis.Analyzer{refcheck.Analyzer}, nil
+}
+
+// main is never called, plugins are loaded by golangci-lint.
+func main() {}
@@ -0,0 +1,168 @@
+// Package env loads struct fields from environment variables.
+//
+// Fields are bound to variables with the `env:"NAME"` tag, and their types define optionality:
+//   - *T stays nil if the variable is not set,
+//   - opt.Opt[T] stays empty if the variable is not set,
+//   - T keeps its current value if the variable is not set.
+//
+// Add the `required` option (`env:"NAME,required"`) to report a missing variable as an error.
+// Nested structs (or pointers to structs) are loaded when tagged with `envPrefix:"PREFIX_"`,
+// the prefix is prepended to all variable names inside. A nil nested pointer stays nil
+// if none of its variables are set, required variables inside are checked only otherwise.
+// A non-nil nested pointer is loaded in place, just like a nested struct.
+// envPrefix on other fields is reported with ErrInvalidTag.
+//
+// Values are parsed with parse.Value: encoding.TextUnmarshaler, time.Duration,
+// strings, booleans, integers and floats are supported.
+package env
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/sr9000/go-ptr-tools/opt"
+	"github.com/sr9000/go-ptr-tools/parse"
+)
+
+const (
+	tagName       = "env"
+	tagPrefixName = "envPrefix"
+	optRequired   = "required"
+)
+
+// Load fills the struct pointed by dst from the process environment.
+func Load(dst any) error {
+	return LoadFunc(dst, os.LookupEnv)
+}
+
+// LoadFunc fills the struct pointed by dst using lookup to get variables, e.g. os.LookupEnv.
+// All missing required variables and parsing failures are reported at once, joined with errors.Join.
+func LoadFunc(dst any, lookup func(string) (string, bool)) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return ErrInvalidTarget
+	}
+
+	l := loader{lookup: lookup}
+	l.loadStruct(rv.Elem(), "")
+
+	return errors.Join(l.errs...)
+}
+
+type loader struct {
+	lookup func(string) (string, bool)
+	errs   []error
+	found  int // number of variables found, used to detect absent nested sections
+}
+
+func (l *loader) loadStruct(rv reflect.Value, prefix string) {
+	rt := rv.Type()
+
+	for i := range rt.NumField() {
+		field := rt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		if nested, ok := field.Tag.Lookup(tagPrefixName); ok {
+			if !isStruct(field.Type) {
+				l.errs = append(l.errs, fmt.Errorf("%w: field %s: %s requires a struct or a pointer to a struct",
+					ErrInvalidTag, field.Name, tagPrefixName))
+
+				continue
+			}
+
+			l.loadNested(rv.Field(i), prefix+nested)
+
+			continue
+		}
+
+		tag, ok := field.Tag.Lookup(tagName)
+		if !ok {
+			continue
+		}
+
+		name, options, _ := strings.Cut(tag, ",")
+		l.loadField(rv.Field(i), prefix+name, slices.Contains(strings.Split(options, ","), optRequired))
+	}
+}
+
+// isStruct reports whether the type is a struct or a pointer to a struct, except opt.Opt.
+func isStruct(typ reflect.Type) bool {
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	return typ.Kind() == reflect.Struct && !opt.IsOptType(typ)
+}
+
+func (l *loader) loadNested(rv reflect.Value, prefix string) {
+	if rv.Kind() != reflect.Pointer {
+		l.loadStruct(rv, prefix)
+
+		return
+	}
+
+	if !rv.IsNil() {
+		l.loadStruct(rv.Elem(), prefix) // keep defaults of the pointed struct
+
+		return
+	}
+
+	// load into a detached loader to keep the pointer nil if the whole section is absent
+	nested := loader{lookup: l.lookup}
+	val := reflect.New(rv.Type().Elem())
+	nested.loadStruct(val.Elem(), prefix)
+
+	if nested.found == 0 {
+		return
+	}
+
+	l.found += nested.found
+	l.errs = append(l.errs, nested.errs...)
+	rv.Set(val)
+}
+
+func (l *loader) loadField(rv reflect.Value, name string, required bool) {
+	raw, ok := l.lookup(name)
+	if !ok {
+		if required {
+			l.errs = append(l.errs, fmt.Errorf("%w: %s", ErrRequired, name))
+		}
+
+		return
+	}
+
+	l.found++
+
+	var typ reflect.Type
+
+	switch {
+	case rv.Kind() == reflect.Pointer:
+		typ = rv.Type().Elem()
+	case opt.IsOptType(rv.Type()):
+		typ = opt.ElemType(rv.Type())
+	default:
+		typ = rv.Type()
+	}
+
+	val, err := parse.Value(typ, raw)
+	if err != nil {
+		l.errs = append(l.errs, fmt.Errorf("%s: %w", name, err))
+
+		return
+	}
+
+	switch {
+	case rv.Kind() == reflect.Pointer:
+		rv.Set(val.Addr())
+	case opt.IsOptType(rv.Type()):
+		opt.ReflectSet(rv, val)
+	default:
+		rv.Set(val)
+	}
+}
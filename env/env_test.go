@@ -0,0 +1,193 @@
+package env_test
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sr9000/go-ptr-tools/env"
+	"github.com/sr9000/go-ptr-tools/opt"
+	"github.com/sr9000/go-ptr-tools/ptr"
+)
+
+type Proxy struct {
+	Host string `env:"HOST,required"`
+	Port *int   `env:"PORT"`
+}
+
+type Database struct {
+	DSN     string                 `env:"DSN,required"`
+	Timeout opt.Opt[time.Duration] `env:"TIMEOUT"`
+}
+
+type Config struct {
+	Name     string       `env:"NAME,required"`
+	Debug    *bool        `env:"DEBUG"`
+	Workers  opt.Opt[int] `env:"WORKERS"`
+	Listen   netip.Addr   `env:"LISTEN"`
+	Level    string       `env:"LEVEL"`
+	Database Database     `envPrefix:"DB_"`
+	Proxy    *Proxy       `envPrefix:"PROXY_"`
+	Ignored  string
+}
+
+func lookupMap(vars map[string]string) func(string) (string, bool) {
+	return func(name string) (string, bool) {
+		v, ok := vars[name]
+
+		return v, ok
+	}
+}
+
+func TestLoadFunc(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		vars     map[string]string
+		expected Config
+	}{
+		{
+			name: "required only",
+			vars: map[string]string{"NAME": "svc", "DB_DSN": "postgres://"},
+			expected: Config{
+				Name:     "svc",
+				Level:    "info",
+				Database: Database{DSN: "postgres://"},
+			},
+		},
+		{
+			name: "zero values are present",
+			vars: map[string]string{
+				"NAME": "svc", "DB_DSN": "postgres://",
+				"DEBUG": "false", "WORKERS": "0", "DB_TIMEOUT": "0s",
+			},
+			expected: Config{
+				Name:     "svc",
+				Debug:    ptr.Of(false),
+				Workers:  opt.Of(0),
+				Level:    "info",
+				Database: Database{DSN: "postgres://", Timeout: opt.Of(time.Duration(0))},
+			},
+		},
+		{
+			name: "all set",
+			vars: map[string]string{
+				"NAME": "svc", "DEBUG": "true", "WORKERS": "8", "LISTEN": "10.0.0.1", "LEVEL": "warn",
+				"DB_DSN": "postgres://", "DB_TIMEOUT": "3s",
+				"PROXY_HOST": "proxy.local", "PROXY_PORT": "3128",
+			},
+			expected: Config{
+				Name:     "svc",
+				Debug:    ptr.Of(true),
+				Workers:  opt.Of(8),
+				Listen:   netip.MustParseAddr("10.0.0.1"),
+				Level:    "warn",
+				Database: Database{DSN: "postgres://", Timeout: opt.Of(3 * time.Second)},
+				Proxy:    &Proxy{Host: "proxy.local", Port: ptr.Of(3128)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := Config{Level: "info"}
+			err := env.LoadFunc(&cfg, lookupMap(tt.vars))
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, cfg)
+		})
+	}
+}
+
+func TestLoadFuncDefaults(t *testing.T) {
+	t.Parallel()
+
+	proxy := &Proxy{Host: "localhost", Port: ptr.Of(8080)}
+	cfg := Config{Proxy: proxy}
+
+	vars := map[string]string{"NAME": "svc", "DB_DSN": "postgres://", "PROXY_HOST": "proxy.local"}
+	require.NoError(t, env.LoadFunc(&cfg, lookupMap(vars)))
+	require.Same(t, proxy, cfg.Proxy)
+	require.Equal(t, &Proxy{Host: "proxy.local", Port: ptr.Of(8080)}, cfg.Proxy, "unset variables keep defaults")
+}
+
+func TestLoadFuncErr(t *testing.T) {
+	t.Parallel()
+
+	t.Run("all missing required reported", func(t *testing.T) {
+		t.Parallel()
+
+		var cfg Config
+
+		err := env.LoadFunc(&cfg, lookupMap(map[string]string{"PROXY_PORT": "8080"}))
+		require.ErrorIs(t, err, env.ErrRequired)
+		require.ErrorContains(t, err, "NAME")
+		require.ErrorContains(t, err, "DB_DSN")
+		require.ErrorContains(t, err, "PROXY_HOST")
+	})
+
+	t.Run("parse errors reported", func(t *testing.T) {
+		t.Parallel()
+
+		var cfg Config
+
+		err := env.LoadFunc(&cfg, lookupMap(map[string]string{
+			"NAME": "svc", "DB_DSN": "postgres://", "WORKERS": "many", "DEBUG": "sure",
+		}))
+		require.ErrorContains(t, err, "WORKERS")
+		require.ErrorContains(t, err, "DEBUG")
+		require.Nil(t, cfg.Debug)
+		require.True(t, cfg.Workers.IsMissing())
+	})
+
+	t.Run("invalid target", func(t *testing.T) {
+		t.Parallel()
+
+		var cfg Config
+
+		require.ErrorIs(t, env.LoadFunc(cfg, lookupMap(nil)), env.ErrInvalidTarget)
+		require.ErrorIs(t, env.LoadFunc((*Config)(nil), lookupMap(nil)), env.ErrInvalidTarget)
+		require.ErrorIs(t, env.LoadFunc(ptr.Of(42), lookupMap(nil)), env.ErrInvalidTarget)
+	})
+
+	t.Run("prefix of not a struct", func(t *testing.T) {
+		t.Parallel()
+
+		var cfg struct {
+			Port  int   `envPrefix:"PORT_"`
+			Debug *bool `envPrefix:"DEBUG_"`
+		}
+
+		err := env.LoadFunc(&cfg, lookupMap(nil))
+		require.ErrorIs(t, err, env.ErrInvalidTag)
+		require.ErrorContains(t, err, "Port")
+		require.ErrorContains(t, err, "Debug")
+	})
+
+	t.Run("required with other options", func(t *testing.T) {
+		t.Parallel()
+
+		var cfg struct {
+			Name string `env:"NAME,foo,required"`
+		}
+
+		require.ErrorIs(t, env.LoadFunc(&cfg, lookupMap(nil)), env.ErrRequired)
+	})
+}
+
+func TestLoad(t *testing.T) {
+	t.Setenv("GO_PTR_TOOLS_TEST_PORT", "8080")
+
+	var cfg struct {
+		Port  *int `env:"GO_PTR_TOOLS_TEST_PORT"`
+		Proxy *int `env:"GO_PTR_TOOLS_TEST_MISSING"`
+	}
+
+	require.NoError(t, env.Load(&cfg))
+	require.Equal(t, ptr.Of(8080), cfg.Port)
+	require.Nil(t, cfg.Proxy)
+}
@@ -0,0 +1,9 @@
+package env
+
+import "errors"
+
+var (
+	ErrInvalidTarget = errors.New("target must be a non-nil pointer to a struct")
+	ErrRequired      = errors.New("required variable is not set")
+	ErrInvalidTag    = errors.New("invalid struct tag")
+)
@@ -0,0 +1,381 @@
+// Package fieldpath resolves dot-separated field paths like "User.Profile.Address.City" or "Items[2].Price"
+// against Go values using reflection.
+package fieldpath
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/sr9000/go-ptr-tools/parse"
+	"github.com/sr9000/go-ptr-tools/ref"
+)
+
+var (
+	ErrSyntax       = errors.New("invalid path syntax")
+	ErrNotFound     = errors.New("path not found")
+	ErrTypeMismatch = errors.New("type mismatch")
+	ErrInvalidRoot  = errors.New("root must be a non-nil pointer")
+)
+
+// OptHooks lets the walker handle opt.Opt values, the opt package cannot be imported here (import cycle).
+type OptHooks struct {
+	IsOpt    func(t reflect.Type) bool
+	ElemType func(t reflect.Type) reflect.Type
+	Get      func(v reflect.Value) (reflect.Value, bool)
+	Set      func(dst, val reflect.Value)
+}
+
+// Walker walks paths through structs, pointers, interfaces, slices, arrays, maps, opt.Opt and ref.Ref values.
+type Walker struct {
+	Opt OptHooks
+}
+
+type step struct {
+	name  string // struct field name (or json tag name), empty for index steps
+	index string // slice, array index or map key
+}
+
+func (s step) String() string {
+	if s.name != "" {
+		return s.name
+	}
+
+	return "[" + s.index + "]"
+}
+
+// parsePath splits the path into steps: fields are separated with dots, indexes and map keys are in brackets.
+func parsePath(path string) ([]step, error) {
+	var steps []step
+
+	for rest := path; ; {
+		name := rest
+		if i := strings.IndexAny(rest, ".["); i >= 0 {
+			name = rest[:i]
+		}
+
+		switch {
+		case name != "":
+			steps = append(steps, step{name: name})
+			rest = rest[len(name):]
+		case len(steps) > 0 || !strings.HasPrefix(rest, "["): // only the root may be indexed directly
+			return nil, fmt.Errorf("%w: empty field name in %q", ErrSyntax, path)
+		}
+
+		for strings.HasPrefix(rest, "[") {
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("%w: unclosed bracket in %q", ErrSyntax, path)
+			}
+
+			if end == 1 {
+				return nil, fmt.Errorf("%w: empty index in %q", ErrSyntax, path)
+			}
+
+			steps = append(steps, step{index: rest[1:end]})
+			rest = rest[end+1:]
+		}
+
+		if rest == "" {
+			return steps, nil
+		}
+
+		if !strings.HasPrefix(rest, ".") {
+			return nil, fmt.Errorf("%w: unexpected %q in %q", ErrSyntax, rest, path)
+		}
+
+		rest = rest[1:]
+	}
+}
+
+// Get returns the value at the path, or false if some pointer, interface, map key or opt.Opt on the way is absent.
+// The value itself is dereferenced as well: nil pointer or missing opt.Opt at the end of the path are absent too.
+func (w Walker) Get(root reflect.Value, path string) (reflect.Value, bool, error) {
+	steps, err := parsePath(path)
+	if err != nil {
+		return reflect.Value{}, false, err
+	}
+
+	return w.get(root, steps)
+}
+
+func (w Walker) get(root reflect.Value, steps []step) (reflect.Value, bool, error) {
+	cur := root
+	for _, s := range steps {
+		var (
+			ok  bool
+			err error
+		)
+
+		cur, ok = w.deref(cur)
+		if !ok {
+			return reflect.Value{}, false, nil
+		}
+
+		cur, ok, err = w.child(cur, s)
+		if !ok || err != nil {
+			return reflect.Value{}, false, err
+		}
+	}
+
+	cur, ok := w.deref(cur)
+
+	return cur, ok, nil
+}
+
+// Set stores val at the path, allocating nil pointers, maps, interfaces (as maps) and missing opt.Opt values on the way.
+// An invalid val resets the target to its zero value.
+func (w Walker) Set(root reflect.Value, path string, val reflect.Value) error {
+	steps, err := parsePath(path)
+	if err != nil {
+		return err
+	}
+
+	if root.Kind() != reflect.Pointer || root.IsNil() {
+		return ErrInvalidRoot
+	}
+
+	return w.set(root.Elem(), steps, val)
+}
+
+// Clear resets the value at the path to zero: nil pointer, missing opt.Opt, or deletes the map key.
+// Absent intermediate values are left as is, there is nothing to clear.
+func (w Walker) Clear(root reflect.Value, path string) error {
+	steps, err := parsePath(path)
+	if err != nil {
+		return err
+	}
+
+	if root.Kind() != reflect.Pointer || root.IsNil() {
+		return ErrInvalidRoot
+	}
+
+	_, ok, err := w.get(root, steps[:len(steps)-1])
+	if !ok || err != nil {
+		return err // nothing to clear if the parent is absent
+	}
+
+	return w.clear(root.Elem(), steps)
+}
+
+// deref unwraps pointers, interfaces, ref.Ref and opt.Opt values, returns false if one is nil or missing.
+func (w Walker) deref(v reflect.Value) (reflect.Value, bool) {
+	for {
+		switch {
+		case w.Opt.IsOpt(v.Type()):
+			inner, ok := w.Opt.Get(v)
+			if !ok {
+				return reflect.Value{}, false
+			}
+
+			v = inner
+		case ref.IsRefType(v.Type()):
+			v = ref.ReflectPtr(v)
+		case v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface:
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+
+			v = v.Elem()
+		default:
+			return v, true
+		}
+	}
+}
+
+// child returns the field, element or map value of the dereferenced value.
+func (w Walker) child(v reflect.Value, s step) (reflect.Value, bool, error) {
+	switch {
+	case s.name != "":
+		if v.Kind() != reflect.Struct {
+			return reflect.Value{}, false, fmt.Errorf("%w: field %s of %s", ErrNotFound, s, v.Type())
+		}
+
+		field, ok := FieldByKey(v.Type(), s.name)
+		if !ok {
+			return reflect.Value{}, false, fmt.Errorf("%w: field %s of %s", ErrNotFound, s, v.Type())
+		}
+
+		return v.FieldByIndex(field.Index), true, nil
+	case v.Kind() == reflect.Map:
+		key, err := parse.Value(v.Type().Key(), s.index)
+		if err != nil {
+			return reflect.Value{}, false, fmt.Errorf("%w: key %s of %s: %w", ErrNotFound, s, v.Type(), err)
+		}
+
+		val := v.MapIndex(key)
+
+		return val, val.IsValid(), nil
+	case v.Kind() == reflect.Slice || v.Kind() == reflect.Array:
+		i, err := strconv.Atoi(s.index)
+		if err != nil || i < 0 || i >= v.Len() {
+			return reflect.Value{}, false, fmt.Errorf("%w: index %s of %s with length %d", ErrNotFound, s, v.Type(), v.Len())
+		}
+
+		return v.Index(i), true, nil
+	default:
+		return reflect.Value{}, false, fmt.Errorf("%w: index %s of %s", ErrNotFound, s, v.Type())
+	}
+}
+
+// alloc unwraps pointers, ref.Ref and opt.Opt values of the settable v, allocating absent ones,
+// then calls fn with the settable unwrapped value.
+func (w Walker) alloc(v reflect.Value, fn func(reflect.Value) error) error {
+	switch {
+	case w.Opt.IsOpt(v.Type()):
+		tmp := reflect.New(w.Opt.ElemType(v.Type())).Elem()
+		if inner, ok := w.Opt.Get(v); ok {
+			tmp.Set(inner)
+		}
+
+		err := w.alloc(tmp, fn)
+		w.Opt.Set(v, tmp)
+
+		return err
+	case ref.IsRefType(v.Type()):
+		p := ref.ReflectPtr(v)
+		if p.IsNil() {
+			p = reflect.New(p.Type().Elem()) // zero Ref is invalid, fix it on the way
+			ref.ReflectSet(v, p)
+		}
+
+		return w.alloc(p.Elem(), fn)
+	case v.Kind() == reflect.Pointer:
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+
+		return w.alloc(v.Elem(), fn)
+	case v.Kind() == reflect.Interface:
+		tmp := reflect.ValueOf(map[string]any{})
+		if !v.IsNil() {
+			tmp = reflect.New(v.Elem().Type()).Elem()
+			tmp.Set(v.Elem())
+		}
+
+		err := w.alloc(tmp, fn)
+		v.Set(tmp)
+
+		return err
+	case v.Kind() == reflect.Map && v.IsNil():
+		v.Set(reflect.MakeMap(v.Type()))
+
+		return fn(v)
+	default:
+		return fn(v)
+	}
+}
+
+func (w Walker) set(v reflect.Value, steps []step, val reflect.Value) error {
+	if len(steps) == 0 {
+		return w.assign(v, val)
+	}
+
+	return w.alloc(v, func(v reflect.Value) error {
+		return w.update(v, steps[0], func(child reflect.Value) error {
+			return w.set(child, steps[1:], val)
+		})
+	})
+}
+
+// clear walks to the parent of the last step (it must be present) and resets the last step.
+func (w Walker) clear(v reflect.Value, steps []step) error {
+	return w.alloc(v, func(v reflect.Value) error {
+		if len(steps) > 1 {
+			return w.update(v, steps[0], func(child reflect.Value) error {
+				return w.clear(child, steps[1:])
+			})
+		}
+
+		if steps[0].name == "" && v.Kind() == reflect.Map {
+			key, err := parse.Value(v.Type().Key(), steps[0].index)
+			if err != nil {
+				return fmt.Errorf("%w: key %s of %s: %w", ErrNotFound, steps[0], v.Type(), err)
+			}
+
+			v.SetMapIndex(key, reflect.Value{})
+
+			return nil
+		}
+
+		child, _, err := w.child(v, steps[0])
+		if err != nil {
+			return err
+		}
+
+		child.SetZero()
+
+		return nil
+	})
+}
+
+// update calls fn with the settable child of the (unwrapped) value v and stores the child back if needed.
+func (w Walker) update(v reflect.Value, s step, fn func(reflect.Value) error) error {
+	if s.name == "" && v.Kind() == reflect.Map {
+		key, err := parse.Value(v.Type().Key(), s.index)
+		if err != nil {
+			return fmt.Errorf("%w: key %s of %s: %w", ErrNotFound, s, v.Type(), err)
+		}
+
+		tmp := reflect.New(v.Type().Elem()).Elem()
+		if cur := v.MapIndex(key); cur.IsValid() {
+			tmp.Set(cur)
+		}
+
+		err = fn(tmp)
+		v.SetMapIndex(key, tmp)
+
+		return err
+	}
+
+	child, _, err := w.child(v, s)
+	if err != nil {
+		return err
+	}
+
+	return fn(child)
+}
+
+// assign stores val into the settable dst, pointers and opt.Opt values are allocated for val.
+func (w Walker) assign(dst, val reflect.Value) error {
+	if !val.IsValid() {
+		dst.SetZero()
+
+		return nil
+	}
+
+	if val.Type().AssignableTo(dst.Type()) {
+		dst.Set(val)
+
+		return nil
+	}
+
+	switch {
+	case w.Opt.IsOpt(dst.Type()):
+		tmp := reflect.New(w.Opt.ElemType(dst.Type())).Elem()
+
+		err := w.assign(tmp, val)
+		if err != nil {
+			return err
+		}
+
+		w.Opt.Set(dst, tmp)
+	case dst.Kind() == reflect.Pointer:
+		tmp := reflect.New(dst.Type().Elem())
+
+		err := w.assign(tmp.Elem(), val)
+		if err != nil {
+			return err
+		}
+
+		dst.Set(tmp)
+	case FitsNumber(val, dst.Type()):
+		dst.Set(val.Convert(dst.Type()))
+	default:
+		return fmt.Errorf("%w: cannot assign %s to %s", ErrTypeMismatch, val.Type(), dst.Type())
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package fieldpath
+
+import (
+	"reflect"
+	"strings"
+)
+
+// IsNumber reports whether values of the kind k are integer or floating point numbers.
+func IsNumber(k reflect.Kind) bool {
+	return (k >= reflect.Int && k <= reflect.Uint64) || k == reflect.Float32 || k == reflect.Float64
+}
+
+// FitsNumber reports whether the number v converts to the number type t without loss:
+// the value keeps its sign, does not overflow and keeps its fraction.
+func FitsNumber(v reflect.Value, t reflect.Type) bool {
+	if !IsNumber(v.Kind()) || !IsNumber(t.Kind()) {
+		return false
+	}
+
+	res := v.Convert(t)
+
+	return isNegative(res) == isNegative(v) && res.Convert(v.Type()).Equal(v)
+}
+
+// isNegative reports whether the number v is less than zero.
+func isNegative(v reflect.Value) bool {
+	switch {
+	case v.CanInt():
+		return v.Int() < 0
+	case v.CanFloat():
+		return v.Float() < 0
+	default:
+		return false
+	}
+}
+
+// FieldKey returns the name of the struct field as it is seen in the JSON: the json tag name or the field name.
+func FieldKey(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" || name == "-" {
+		return field.Name
+	}
+
+	return name
+}
+
+// FieldByKey finds the exported struct field matching the key by name or by json tag name.
+func FieldByKey(t reflect.Type, key string) (reflect.StructField, bool) {
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if field.IsExported() && (field.Name == key || FieldKey(field) == key) {
+			return field, true
+		}
+	}
+
+	return reflect.StructField{}, false
+}
+
+// IsNilable reports whether values of the kind k can be nil.
+func IsNilable(k reflect.Kind) bool {
+	switch k {
+	case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Interface, reflect.Func, reflect.Chan:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsSkipped reports whether the struct field is not seen in the JSON: unexported or tagged `json:"-"`.
+func IsSkipped(field reflect.StructField) bool {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+
+	return !field.IsExported() || name == "-"
+}
+
+// JoinPath appends the field name to the dot-separated path.
+func JoinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
@@ -0,0 +1,96 @@
+package fieldpath_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sr9000/go-ptr-tools/internal/fieldpath"
+)
+
+func TestFitsNumber(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		val      any
+		typ      reflect.Type
+		expected bool
+	}{
+		{"int to uint8", 200, reflect.TypeFor[uint8](), true},
+		{"int overflows uint8", 300, reflect.TypeFor[uint8](), false},
+		{"negative int to uint", -1, reflect.TypeFor[uint](), false},
+		{"negative int8 to uint8", int8(-1), reflect.TypeFor[uint8](), false},
+		{"max uint64 to int64", uint64(math.MaxUint64), reflect.TypeFor[int64](), false},
+		{"whole float to int", 42.0, reflect.TypeFor[int](), true},
+		{"fraction to int", 1.5, reflect.TypeFor[int](), false},
+		{"negative float to uint", -1.0, reflect.TypeFor[uint](), false},
+		{"nan to float32", math.NaN(), reflect.TypeFor[float32](), false},
+		{"negative int to float", -3, reflect.TypeFor[float64](), true},
+		{"string", "1", reflect.TypeFor[int](), false},
+		{"to string", 1, reflect.TypeFor[string](), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.expected, fieldpath.FitsNumber(reflect.ValueOf(tt.val), tt.typ))
+		})
+	}
+}
+
+func TestFieldByKey(t *testing.T) {
+	t.Parallel()
+
+	type User struct {
+		Name    string `json:"name,omitempty"`
+		Email   string
+		Secret  string `json:"-"`
+		private string
+	}
+
+	typ := reflect.TypeFor[User]()
+
+	tests := []struct {
+		key      string
+		expected string
+	}{
+		{"name", "Name"},
+		{"Name", "Name"},
+		{"Email", "Email"},
+		{"Secret", "Secret"},
+		{"private", ""},
+		{"-", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			t.Parallel()
+
+			field, ok := fieldpath.FieldByKey(typ, tt.key)
+			require.Equal(t, tt.expected != "", ok)
+			require.Equal(t, tt.expected, field.Name)
+		})
+	}
+
+	require.Equal(t, "name", fieldpath.FieldKey(typ.Field(0)))
+	require.Equal(t, "Email", fieldpath.FieldKey(typ.Field(1)))
+	require.Equal(t, []bool{false, false, true, true}, []bool{
+		fieldpath.IsSkipped(typ.Field(0)),
+		fieldpath.IsSkipped(typ.Field(1)),
+		fieldpath.IsSkipped(typ.Field(2)),
+		fieldpath.IsSkipped(typ.Field(3)),
+	})
+}
+
+func TestJoinPath(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "User", fieldpath.JoinPath("", "User"))
+	require.Equal(t, "User.Profile", fieldpath.JoinPath("User", "Profile"))
+	require.True(t, fieldpath.IsNilable(reflect.Map))
+	require.False(t, fieldpath.IsNilable(reflect.Struct))
+}
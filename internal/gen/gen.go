@@ -0,0 +1,444 @@
+// Package gen holds the parts shared by code generator commands (cmd/ptrgetters, etc.):
+// loading struct declarations of a package with go/parser (names of imported packages are resolved
+// with go/packages) and writing gofmt-ed output.
+package gen
+
+import (
+	"bytes"
+	"cmp"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"golang.org/x/tools/go/packages"
+)
+
+const ownerWritePermission = 0o644
+
+var (
+	ErrNoPackage        = errors.New("no Go files")
+	ErrMultiplePackages = errors.New("multiple packages")
+)
+
+// Package is a parsed package directory.
+type Package struct {
+	Name    string
+	Structs []*Struct
+	methods map[string]map[string]bool // type name (empty for functions) -> declared method names
+}
+
+// Struct is a struct type declaration.
+type Struct struct {
+	Name       string
+	TypeParams string   // type parameters with constraints, e.g. "[K comparable, V any]", empty if not generic
+	TypeArgs   string   // type parameters as arguments, e.g. "[K, V]"
+	Directives []string // "//ptrtools:..." comment lines of the declaration, without slashes
+	Fields     []*Field
+}
+
+// Field is a named exported struct field.
+type Field struct {
+	Name    string
+	Type    string // type expression as written, e.g. "*time.Duration"
+	Elem    string // pointee type for pointer fields, e.g. "time.Duration", empty otherwise
+	Tag     reflect.StructTag
+	Imports []Import // imports used by the type
+}
+
+// Import is an import spec used by a field type.
+type Import struct {
+	Name string // explicit import name, empty if none
+	Path string
+}
+
+// Receiver returns the receiver name for methods of the struct: the lowercased first letter of its name.
+func (s *Struct) Receiver() string {
+	return strings.ToLower(s.Name[:1])
+}
+
+// Directive finds the `//ptrtools:<name> key=value ...` directive of the struct and returns its arguments.
+func (s *Struct) Directive(name string) (map[string]string, bool) {
+	for _, directive := range s.Directives {
+		fields := strings.Fields(directive)
+		if len(fields) == 0 || fields[0] != name {
+			continue
+		}
+
+		args := make(map[string]string, len(fields)-1)
+		for _, arg := range fields[1:] {
+			key, val, _ := strings.Cut(arg, "=")
+			args[key] = val
+		}
+
+		return args, true
+	}
+
+	return nil, false
+}
+
+// Imports returns the imports used by types of the fields.
+func (s *Struct) Imports() []Import {
+	var res []Import
+
+	for _, field := range s.Fields {
+		res = AppendImports(res, field.Imports...)
+	}
+
+	return res
+}
+
+// AppendImports appends imports missing in the list.
+func AppendImports(imports []Import, add ...Import) []Import {
+	for _, imp := range add {
+		if !slices.Contains(imports, imp) {
+			imports = append(imports, imp)
+		}
+	}
+
+	return imports
+}
+
+// HasMethod reports whether the method is declared for the type in the package.
+func (p *Package) HasMethod(typeName, method string) bool {
+	return p.methods[typeName][method]
+}
+
+// HasFunc reports whether the top-level function is declared in the package.
+func (p *Package) HasFunc(name string) bool {
+	return p.methods[""][name]
+}
+
+// Struct returns the struct declared in the package by name.
+func (p *Package) Struct(name string) (*Struct, bool) {
+	for _, s := range p.Structs {
+		if s.Name == name {
+			return s, true
+		}
+	}
+
+	return nil, false
+}
+
+// Load parses not test Go files of the directory, skipping files the skip func reports true for
+// (e.g. the output file of the generator itself, so it can be regenerated).
+func Load(dir string, skip func(filename string) bool) (*Package, error) {
+	entries, err := os.ReadDir(dir) // sorted by filename, the output is deterministic
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+	res := &Package{methods: make(map[string]map[string]bool)}
+	names := importNames(dir)
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") || skip(name) {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+
+		if res.Name != "" && res.Name != file.Name.Name {
+			return nil, fmt.Errorf("%w: %s and %s in %s", ErrMultiplePackages, res.Name, file.Name.Name, dir)
+		}
+
+		res.Name = file.Name.Name
+		res.loadFile(file, names)
+	}
+
+	if res.Name == "" {
+		return nil, fmt.Errorf("%w: %s", ErrNoPackage, dir)
+	}
+
+	return res, nil
+}
+
+func (p *Package) loadFile(file *ast.File, names map[string]string) {
+	imports := make(map[string]Import)
+
+	for _, spec := range file.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		imp := Import{Path: path}
+
+		name, ok := names[path]
+		if !ok {
+			name = assumedName(path)
+		}
+
+		if spec.Name != nil {
+			imp.Name = spec.Name.Name
+			name = spec.Name.Name
+		}
+
+		imports[name] = imp
+	}
+
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			p.addMethod(decl)
+		case *ast.GenDecl:
+			if decl.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range decl.Specs {
+				typeSpec, ok := spec.(*ast.TypeSpec)
+				if !ok {
+					continue
+				}
+
+				if structType, ok := typeSpec.Type.(*ast.StructType); ok {
+					p.Structs = append(p.Structs, newStruct(decl, typeSpec, structType, imports))
+				}
+			}
+		}
+	}
+}
+
+// importNames resolves the names of the packages imported by the package in dir, keyed by import paths.
+// Packages failing to load are missing, e.g. when the module is not downloaded.
+func importNames(dir string) map[string]string {
+	res := make(map[string]string)
+
+	cfg := &packages.Config{Mode: packages.NeedName | packages.NeedImports | packages.NeedDeps, Dir: dir}
+
+	pkgs, err := packages.Load(cfg, ".")
+	if err != nil {
+		return res
+	}
+
+	for _, pkg := range pkgs {
+		for path, imp := range pkg.Imports {
+			if imp.Name != "" {
+				res[path] = imp.Name
+			}
+		}
+	}
+
+	return res
+}
+
+// assumedName guesses the name of the package by its import path the way goimports does:
+// "example.com/foo/v2" is foo, "gopkg.in/yaml.v3" is yaml and "github.com/x/go-bar" is bar.
+func assumedName(path string) string {
+	elems := strings.Split(path, "/")
+
+	base := elems[len(elems)-1]
+	if len(elems) > 1 && isMajorVersion(base) {
+		base = elems[len(elems)-2]
+	}
+
+	base = strings.TrimPrefix(base, "go-")
+	notIdentifier := func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' }
+	if i := strings.IndexFunc(base, notIdentifier); i >= 0 {
+		base = base[:i]
+	}
+
+	return base
+}
+
+// isMajorVersion reports whether the import path element is a major version suffix like v2.
+func isMajorVersion(elem string) bool {
+	digits, ok := strings.CutPrefix(elem, "v")
+	if !ok || digits == "" {
+		return false
+	}
+
+	return strings.Trim(digits, "0123456789") == ""
+}
+
+func (p *Package) addMethod(decl *ast.FuncDecl) {
+	if decl.Recv == nil || len(decl.Recv.List) == 0 {
+		p.addMethodName("", decl.Name.Name) // top-level function
+
+		return
+	}
+
+	recv := decl.Recv.List[0].Type
+	if star, ok := recv.(*ast.StarExpr); ok {
+		recv = star.X
+	}
+
+	switch expr := recv.(type) {
+	case *ast.IndexExpr:
+		recv = expr.X
+	case *ast.IndexListExpr:
+		recv = expr.X
+	}
+
+	ident, ok := recv.(*ast.Ident)
+	if !ok {
+		return
+	}
+
+	p.addMethodName(ident.Name, decl.Name.Name)
+}
+
+func (p *Package) addMethodName(typeName, method string) {
+	if p.methods[typeName] == nil {
+		p.methods[typeName] = make(map[string]bool)
+	}
+
+	p.methods[typeName][method] = true
+}
+
+func newStruct(decl *ast.GenDecl, spec *ast.TypeSpec, structType *ast.StructType, imports map[string]Import) *Struct {
+	res := &Struct{Name: spec.Name.Name}
+
+	for _, doc := range []*ast.CommentGroup{decl.Doc, spec.Doc} {
+		if doc == nil {
+			continue
+		}
+
+		for _, comment := range doc.List {
+			if directive, ok := strings.CutPrefix(comment.Text, "//ptrtools:"); ok {
+				res.Directives = append(res.Directives, strings.TrimSpace(directive))
+			}
+		}
+	}
+
+	if spec.TypeParams != nil {
+		var params, args []string
+
+		for _, field := range spec.TypeParams.List {
+			for _, name := range field.Names {
+				params = append(params, name.Name+" "+types.ExprString(field.Type))
+				args = append(args, name.Name)
+			}
+		}
+
+		res.TypeParams = "[" + strings.Join(params, ", ") + "]"
+		res.TypeArgs = "[" + strings.Join(args, ", ") + "]"
+	}
+
+	for _, field := range structType.Fields.List {
+		for _, name := range field.Names {
+			if !name.IsExported() {
+				continue
+			}
+
+			f := &Field{Name: name.Name, Type: types.ExprString(field.Type), Imports: usedImports(field.Type, imports)}
+			if star, ok := field.Type.(*ast.StarExpr); ok {
+				f.Elem = types.ExprString(star.X)
+			}
+
+			if field.Tag != nil {
+				tag, _ := strconv.Unquote(field.Tag.Value)
+				f.Tag = reflect.StructTag(tag)
+			}
+
+			res.Fields = append(res.Fields, f)
+		}
+	}
+
+	return res
+}
+
+// usedImports returns imports of packages referenced by the type expression.
+func usedImports(expr ast.Expr, imports map[string]Import) []Import {
+	var res []Import
+
+	ast.Inspect(expr, func(node ast.Node) bool {
+		if sel, ok := node.(*ast.SelectorExpr); ok {
+			if ident, ok := sel.X.(*ast.Ident); ok {
+				if imp, ok := imports[ident.Name]; ok && !slices.Contains(res, imp) {
+					res = append(res, imp)
+				}
+			}
+		}
+
+		return true
+	})
+
+	return res
+}
+
+// Generate executes the template with data, prepends the header and the imports, formats the code
+// and writes it to the file.
+func Generate(filename, generator, pkg string, imports []Import, tmpl *template.Template, data any) error {
+	src, err := Render(generator, pkg, imports, tmpl, data)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, src, fs.FileMode(ownerWritePermission))
+}
+
+// Render works as Generate, but returns the code instead of writing it.
+func Render(generator, pkg string, imports []Import, tmpl *template.Template, data any) ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.WriteString("// Code generated by " + generator + "; DO NOT EDIT.\n\n")
+	buf.WriteString("package " + pkg + "\n\n")
+
+	if len(imports) > 0 {
+		imports = slices.Clone(imports)
+		slices.SortStableFunc(imports, func(a, b Import) int { // standard library first, like goimports does
+			return cmp.Or(cmp.Compare(importGroup(a.Path), importGroup(b.Path)), cmp.Compare(a.Path, b.Path))
+		})
+
+		buf.WriteString("import (\n")
+
+		for i, imp := range imports {
+			if i > 0 && importGroup(imp.Path) != importGroup(imports[i-1].Path) {
+				buf.WriteString("\n")
+			}
+
+			buf.WriteString("\t" + strings.TrimSpace(imp.Name+" "+strconv.Quote(imp.Path)) + "\n")
+		}
+
+		buf.WriteString(")\n")
+	}
+
+	err := tmpl.Execute(&buf, data)
+	if err != nil {
+		return nil, err
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("format generated code: %w\n%s", err, buf.Bytes())
+	}
+
+	return src, nil
+}
+
+// importGroup returns 0 for the standard library import paths and 1 for the rest (with a domain).
+func importGroup(path string) int {
+	first, _, _ := strings.Cut(path, "/")
+	if strings.Contains(first, ".") {
+		return 1
+	}
+
+	return 0
+}
+
+// FuncMap returns template functions shared by generators.
+func FuncMap() template.FuncMap {
+	return template.FuncMap{
+		"lowerFirst": func(s string) string {
+			r := []rune(s)
+			r[0] = unicode.ToLower(r[0])
+
+			return string(r)
+		},
+	}
+}
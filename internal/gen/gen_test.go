@@ -0,0 +1,31 @@
+package gen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssumedName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		path     string
+		expected string
+	}{
+		{"time", "time"},
+		{"math/rand/v2", "rand"},
+		{"example.com/foo/v2", "foo"},
+		{"gopkg.in/yaml.v3", "yaml"},
+		{"github.com/sr9000/go-ptr-tools", "ptr"},
+		{"v2", "v2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.expected, assumedName(tt.path))
+		})
+	}
+}
@@ -0,0 +1,233 @@
+// Package mapstruct binds dynamic map[string]any values (decoded JSON, template data, rule engine output)
+// to structs and back, without third-party dependencies.
+//
+// Struct fields are matched with map keys by json tag name or by field name, `json:"-"` fields are skipped.
+// Field types define optionality:
+//   - *T and opt.Opt[T] stay empty if the key is absent or null,
+//   - ref.Ref[T] is mandatory, an absent or null key is reported with ErrRequired,
+//   - T keeps its current value if the key is absent or null.
+//
+// Values are converted safely: numbers between numeric types only if the conversion is lossless,
+// json.Number into numbers, strings into types parsed from text (encoding.TextUnmarshaler, time.Duration, string kinds)
+// with parse.Value, and the rest only if assignable. Strings like "42" or "true" fill number and bool fields
+// only with Decoder.WeakStrings, e.g. for query parameters or environment values.
+package mapstruct
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/sr9000/go-ptr-tools/internal/fieldpath"
+	"github.com/sr9000/go-ptr-tools/opt"
+	"github.com/sr9000/go-ptr-tools/parse"
+	"github.com/sr9000/go-ptr-tools/ref"
+)
+
+//nolint:gochecknoglobals // types used in conversion checks
+var (
+	durationType = reflect.TypeFor[time.Duration]()
+	numberType   = reflect.TypeFor[json.Number]()
+	textType     = reflect.TypeFor[encoding.TextUnmarshaler]()
+)
+
+// Decoder configures decoding, the zero value is used by Decode.
+type Decoder struct {
+	// WeakStrings lets strings fill number and bool fields, e.g. "42" an int field and "true" a bool field.
+	WeakStrings bool
+}
+
+// Decode fills the struct pointed by dst from src with the zero Decoder.
+func Decode(src map[string]any, dst any) error {
+	return Decoder{}.Decode(src, dst)
+}
+
+// Decode fills the struct pointed by dst from src.
+// All missing required fields and conversion failures are reported at once, joined with errors.Join,
+// each prefixed with the dot-separated field path (e.g. "Items[2].Price"). Dst may be partially filled on error.
+func (c Decoder) Decode(src map[string]any, dst any) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return ErrInvalidTarget
+	}
+
+	d := decoder{weak: c.WeakStrings}
+	d.decodeStruct(rv.Elem(), reflect.ValueOf(src), "")
+
+	return errors.Join(d.errs...)
+}
+
+type decoder struct {
+	weak bool
+	errs []error
+}
+
+func (d *decoder) fail(path string, err error) {
+	d.errs = append(d.errs, fmt.Errorf("%s: %w", path, err))
+}
+
+// decodeStruct fills the struct dst from the map src with string keys.
+func (d *decoder) decodeStruct(dst, src reflect.Value, path string) {
+	for i := range dst.NumField() {
+		field := dst.Type().Field(i)
+
+		if fieldpath.IsSkipped(field) {
+			continue
+		}
+
+		key := fieldpath.FieldKey(field)
+		fieldPath := fieldpath.JoinPath(path, field.Name)
+
+		val := src.MapIndex(reflect.ValueOf(key).Convert(src.Type().Key()))
+		d.decodeValue(dst.Field(i), val, fieldPath)
+	}
+}
+
+// decodeValue converts src into the settable dst, dst is left untouched on error.
+// Absent (invalid) or nil src is reported for ref.Ref and ignored otherwise.
+func (d *decoder) decodeValue(dst, src reflect.Value, path string) {
+	for src.IsValid() && (src.Kind() == reflect.Interface || src.Kind() == reflect.Pointer) {
+		src = src.Elem()
+	}
+
+	if !src.IsValid() || (fieldpath.IsNilable(src.Kind()) && src.IsNil()) {
+		if ref.IsRefType(dst.Type()) {
+			d.fail(path, ErrRequired)
+		}
+
+		return
+	}
+
+	switch {
+	case opt.IsOptType(dst.Type()):
+		tmp := reflect.New(opt.ElemType(dst.Type())).Elem()
+		if d.decodeInto(tmp, src, path) {
+			opt.ReflectSet(dst, tmp)
+		}
+	case ref.IsRefType(dst.Type()):
+		tmp := reflect.New(ref.ReflectPtr(dst).Type().Elem())
+		if d.decodeInto(tmp.Elem(), src, path) {
+			ref.ReflectSet(dst, tmp)
+		}
+	case dst.Kind() == reflect.Pointer:
+		tmp := reflect.New(dst.Type().Elem())
+		if d.decodeInto(tmp.Elem(), src, path) {
+			dst.Set(tmp)
+		}
+	default:
+		d.decodeInto(dst, src, path)
+	}
+}
+
+// decodeInto converts src into the settable dst and reports whether it succeeded.
+func (d *decoder) decodeInto(dst, src reflect.Value, path string) bool {
+	errs := len(d.errs)
+
+	switch {
+	case src.Type().AssignableTo(dst.Type()):
+		dst.Set(src)
+	case dst.Kind() == reflect.Pointer || opt.IsOptType(dst.Type()) || ref.IsRefType(dst.Type()):
+		d.decodeValue(dst, src, path) // e.g. []*T elements
+	case isNested(dst.Type()) && isObject(src.Type()):
+		d.decodeStruct(dst, src, path)
+	case dst.Kind() == reflect.Map && isObject(src.Type()):
+		d.decodeMap(dst, src, path)
+	case (dst.Kind() == reflect.Slice || dst.Kind() == reflect.Array) &&
+		(src.Kind() == reflect.Slice || src.Kind() == reflect.Array):
+		d.decodeSlice(dst, src, path)
+	case src.Kind() == reflect.String && !d.weak && !isText(src.Type(), dst.Type()):
+		d.fail(path, fmt.Errorf("%w: cannot convert string %q to %s", ErrTypeMismatch, src.String(), dst.Type()))
+	case src.Kind() == reflect.String:
+		val, err := parse.Value(dst.Type(), src.String())
+		if err != nil {
+			d.fail(path, fmt.Errorf("%w: cannot convert %q to %s: %w", ErrTypeMismatch, src.String(), dst.Type(), err))
+
+			break
+		}
+
+		dst.Set(val)
+	case fieldpath.FitsNumber(src, dst.Type()):
+		dst.Set(src.Convert(dst.Type()))
+	default:
+		d.fail(path, fmt.Errorf("%w: cannot convert %s to %s", ErrTypeMismatch, src.Type(), dst.Type()))
+	}
+
+	return len(d.errs) == errs
+}
+
+func (d *decoder) decodeMap(dst, src reflect.Value, path string) {
+	res := reflect.MakeMapWithSize(dst.Type(), src.Len())
+
+	for iter := src.MapRange(); iter.Next(); {
+		keyPath := path + "[" + iter.Key().String() + "]"
+
+		key, err := parse.Value(dst.Type().Key(), iter.Key().String())
+		if err != nil {
+			d.fail(keyPath, fmt.Errorf("%w: key: %w", ErrTypeMismatch, err))
+
+			continue
+		}
+
+		val := reflect.New(dst.Type().Elem()).Elem()
+		d.decodeValue(val, iter.Value(), keyPath)
+
+		res.SetMapIndex(key, val)
+	}
+
+	dst.Set(res)
+}
+
+func (d *decoder) decodeSlice(dst, src reflect.Value, path string) {
+	res := dst
+	if dst.Kind() == reflect.Slice {
+		res = reflect.MakeSlice(dst.Type(), src.Len(), src.Len())
+	} else if src.Len() > dst.Len() {
+		d.fail(path, fmt.Errorf("%w: cannot fit %d elements into %s", ErrTypeMismatch, src.Len(), dst.Type()))
+
+		return
+	}
+
+	for i := range src.Len() {
+		d.decodeValue(res.Index(i), src.Index(i), path+"["+strconv.Itoa(i)+"]")
+	}
+
+	dst.Set(res)
+}
+
+// isNested reports whether t is a struct to bind field by field: a struct (but not opt.Opt or ref.Ref)
+// with exported fields. Structs like time.Time are treated as leaf values.
+func isNested(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct || opt.IsOptType(t) || ref.IsRefType(t) {
+		return false
+	}
+
+	for i := range t.NumField() {
+		if t.Field(i).IsExported() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isText reports whether strings of the type src are parsed into dst without WeakStrings:
+// json.Number into numbers, any string into types other than numbers and bools.
+func isText(src, dst reflect.Type) bool {
+	switch {
+	case dst == durationType || reflect.PointerTo(dst).Implements(textType):
+		return true
+	case fieldpath.IsNumber(dst.Kind()):
+		return src == numberType
+	default:
+		return dst.Kind() != reflect.Bool
+	}
+}
+
+// isObject reports whether values of the type t can be bound to structs: maps with string keys.
+func isObject(t reflect.Type) bool {
+	return t.Kind() == reflect.Map && t.Key().Kind() == reflect.String
+}
@@ -0,0 +1,124 @@
+package mapstruct
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/sr9000/go-ptr-tools/internal/fieldpath"
+	"github.com/sr9000/go-ptr-tools/opt"
+	"github.com/sr9000/go-ptr-tools/ref"
+)
+
+// Encode converts the struct src (or a pointer to it) into a map keyed by json tag names or field names, Decode reversed.
+//   - Nil pointers, slices, maps, interfaces and missing opt.Opt fields are omitted.
+//   - Nested structs become nested maps, slices and arrays become []any, maps become map[string]any.
+//   - Pointers, opt.Opt and ref.Ref values are dereferenced, the rest values (including time.Time) are kept as is.
+//
+// Invalid (zero) ref.Ref values are reported with ErrRequired and cyclic values with ErrCycle,
+// all errors are joined with errors.Join.
+func Encode(src any) (map[string]any, error) {
+	rv := reflect.ValueOf(src)
+	if rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() != reflect.Struct {
+		return nil, ErrInvalidSource
+	}
+
+	e := encoder{visiting: make(map[visitKey]bool)}
+	res := e.encodeStruct(rv, "")
+
+	return res, errors.Join(e.errs...)
+}
+
+// visitKey identifies a pointer by its address and type, the first field of a struct shares its address.
+type visitKey struct {
+	addr uintptr
+	typ  reflect.Type
+}
+
+type encoder struct {
+	visiting map[visitKey]bool // pointers on the current path
+	errs     []error
+}
+
+func (e *encoder) encodeStruct(src reflect.Value, path string) map[string]any {
+	res := make(map[string]any, src.NumField())
+
+	for i := range src.NumField() {
+		field := src.Type().Field(i)
+		if fieldpath.IsSkipped(field) {
+			continue
+		}
+
+		if val, ok := e.encodeValue(src.Field(i), fieldpath.JoinPath(path, field.Name)); ok {
+			res[fieldpath.FieldKey(field)] = val
+		}
+	}
+
+	return res
+}
+
+// encodeValue returns the encoded value and false if it is absent.
+func (e *encoder) encodeValue(src reflect.Value, path string) (any, bool) {
+	switch {
+	case opt.IsOptType(src.Type()):
+		val, ok := opt.ReflectGet(src)
+		if !ok {
+			return nil, false
+		}
+
+		return e.encodeValue(val, path)
+	case ref.IsRefType(src.Type()):
+		p := ref.ReflectPtr(src)
+		if p.IsNil() {
+			e.errs = append(e.errs, fmt.Errorf("%s: %w", path, ErrRequired))
+
+			return nil, false
+		}
+
+		return e.encodeValue(p, path)
+	case fieldpath.IsNilable(src.Kind()) && src.IsNil():
+		return nil, false
+	case src.Kind() == reflect.Pointer:
+		key := visitKey{src.Pointer(), src.Type()}
+		if e.visiting[key] {
+			e.errs = append(e.errs, fmt.Errorf("%s: %w", path, ErrCycle))
+
+			return nil, false
+		}
+
+		e.visiting[key] = true
+		defer delete(e.visiting, key)
+
+		return e.encodeValue(src.Elem(), path)
+	case src.Kind() == reflect.Interface:
+		return e.encodeValue(src.Elem(), path)
+	case isNested(src.Type()):
+		return e.encodeStruct(src, path), true
+	case src.Kind() == reflect.Map:
+		res := make(map[string]any, src.Len())
+		for iter := src.MapRange(); iter.Next(); {
+			key := fmt.Sprint(iter.Key().Interface())
+			if val, ok := e.encodeValue(iter.Value(), path+"["+key+"]"); ok {
+				res[key] = val
+			} else {
+				res[key] = nil
+			}
+		}
+
+		return res, true
+	case (src.Kind() == reflect.Slice && src.Type().Elem().Kind() != reflect.Uint8) || src.Kind() == reflect.Array:
+		res := make([]any, src.Len())
+		for i := range src.Len() {
+			res[i], _ = e.encodeValue(src.Index(i), path+"["+strconv.Itoa(i)+"]")
+		}
+
+		return res, true
+	default:
+		return src.Interface(), true
+	}
+}
@@ -0,0 +1,11 @@
+package mapstruct
+
+import "errors"
+
+var (
+	ErrInvalidTarget = errors.New("target must be a non-nil pointer to a struct")
+	ErrInvalidSource = errors.New("source must be a struct or a non-nil pointer to a struct")
+	ErrRequired      = errors.New("required field is missing")
+	ErrTypeMismatch  = errors.New("type mismatch")
+	ErrCycle         = errors.New("cyclic value")
+)
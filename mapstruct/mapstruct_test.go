@@ -0,0 +1,246 @@
+package mapstruct_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sr9000/go-ptr-tools/mapstruct"
+	"github.com/sr9000/go-ptr-tools/opt"
+	"github.com/sr9000/go-ptr-tools/ptr"
+	"github.com/sr9000/go-ptr-tools/ref"
+)
+
+type Level int
+
+type Line struct {
+	SKU   string  `json:"sku"`
+	Qty   uint8   `json:"qty"`
+	Price float64 `json:"price"`
+}
+
+type Customer struct {
+	Name  string  `json:"name"`
+	Email *string `json:"email"`
+}
+
+type Order struct {
+	ID       int64                     `json:"id"`
+	Customer ref.Ref[Customer]         `json:"customer"`
+	Note     *string                   `json:"note"`
+	Priority opt.Opt[Level]            `json:"priority"`
+	Timeout  time.Duration             `json:"timeout"`
+	Created  time.Time                 `json:"created"`
+	Lines    []Line                    `json:"lines"`
+	Extras   map[string]*Line          `json:"extras"`
+	Counts   map[int]int               `json:"counts"`
+	Tags     [2]string                 `json:"tags"`
+	Attrs    any                       `json:"attrs"`
+	Discount opt.Opt[ref.Ref[float64]] `json:"discount"`
+	Internal string                    `json:"-"`
+}
+
+func decodeJSON(t *testing.T, raw string) map[string]any {
+	t.Helper()
+
+	var res map[string]any
+
+	dec := json.NewDecoder(bytes.NewReader([]byte(raw)))
+	dec.UseNumber()
+	require.NoError(t, dec.Decode(&res))
+
+	return res
+}
+
+func TestDecode(t *testing.T) {
+	t.Parallel()
+
+	src := decodeJSON(t, `{
+		"id": 42,
+		"customer": {"name": "bob"},
+		"priority": 2,
+		"timeout": "1m",
+		"created": "2025-01-02T03:04:05Z",
+		"lines": [{"sku": "a", "qty": 3, "price": 1.5}],
+		"extras": {"gift": {"sku": "g"}, "none": null},
+		"counts": {"7": 49},
+		"tags": ["x"],
+		"attrs": {"k": [1]},
+		"discount": 0.1,
+		"Internal": "secret",
+		"unknown": true
+	}`)
+
+	var order Order
+	require.NoError(t, mapstruct.Decode(src, &order))
+
+	require.Equal(t, Order{
+		ID:       42,
+		Customer: ref.Of(Customer{Name: "bob"}),
+		Priority: opt.Of(Level(2)),
+		Timeout:  time.Minute,
+		Created:  time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		Lines:    []Line{{SKU: "a", Qty: 3, Price: 1.5}},
+		Extras:   map[string]*Line{"gift": {SKU: "g"}, "none": nil},
+		Counts:   map[int]int{7: 49},
+		Tags:     [2]string{"x"},
+		Attrs:    map[string]any{"k": []any{json.Number("1")}},
+		Discount: opt.Of(ref.Of(0.1)),
+	}, order)
+}
+
+func TestDecodeNative(t *testing.T) {
+	t.Parallel()
+
+	var order Order
+	require.NoError(t, mapstruct.Decode(map[string]any{
+		"id":       42.0,
+		"customer": map[string]string{"name": "bob", "email": "bob@example.com"},
+		"note":     ptr.Of("fragile"),
+		"priority": 1,
+		"timeout":  int64(time.Second),
+		"lines":    []map[string]any{{"qty": 3.0}},
+	}, &order))
+
+	require.Equal(t, Order{
+		ID:       42,
+		Customer: ref.Of(Customer{Name: "bob", Email: ptr.Of("bob@example.com")}),
+		Note:     ptr.Of("fragile"),
+		Priority: opt.Of(Level(1)),
+		Timeout:  time.Second,
+		Lines:    []Line{{Qty: 3}},
+	}, order)
+}
+
+func TestDecodeErr(t *testing.T) {
+	t.Parallel()
+
+	src := map[string]any{
+		"id":       1.5,
+		"priority": "high",
+		"lines":    []any{map[string]any{"qty": 300}, map[string]any{"qty": -1}, map[string]any{"qty": int8(-1)}},
+		"counts":   map[string]any{"x": 1},
+		"tags":     []any{"a", "b", "c"},
+		"discount": nil,
+	}
+
+	var order Order
+	err := mapstruct.Decode(src, &order)
+	require.ErrorIs(t, err, mapstruct.ErrRequired)
+	require.ErrorIs(t, err, mapstruct.ErrTypeMismatch)
+
+	paths := []string{"ID:", "Customer:", "Priority:", "Lines[0].Qty:", "Lines[1].Qty:", "Lines[2].Qty:", "Counts[x]:", "Tags:"}
+	for _, path := range paths {
+		require.ErrorContains(t, err, path)
+	}
+
+	require.NotContains(t, err.Error(), "Discount", "null opt is absent")
+
+	require.ErrorIs(t, mapstruct.Decode(src, order), mapstruct.ErrInvalidTarget)
+	require.ErrorIs(t, mapstruct.Decode(src, (*Order)(nil)), mapstruct.ErrInvalidTarget)
+}
+
+func TestDecodeWeakStrings(t *testing.T) {
+	t.Parallel()
+
+	type Flags struct {
+		Port    int            `json:"port"`
+		Debug   *bool          `json:"debug"`
+		Timeout time.Duration  `json:"timeout"`
+		Level   opt.Opt[Level] `json:"level"`
+	}
+
+	src := map[string]any{"port": "8080", "debug": "true", "timeout": "1m", "level": json.Number("2")}
+
+	var strict Flags
+	err := mapstruct.Decode(src, &strict)
+	require.ErrorIs(t, err, mapstruct.ErrTypeMismatch)
+	require.ErrorContains(t, err, "Port:")
+	require.ErrorContains(t, err, "Debug:")
+	require.Equal(t, Flags{Timeout: time.Minute, Level: opt.Of(Level(2))}, strict)
+
+	var weak Flags
+	require.NoError(t, mapstruct.Decoder{WeakStrings: true}.Decode(src, &weak))
+	require.Equal(t, Flags{Port: 8080, Debug: ptr.Of(true), Timeout: time.Minute, Level: opt.Of(Level(2))}, weak)
+}
+
+func TestEncode(t *testing.T) {
+	t.Parallel()
+
+	order := Order{
+		ID:       42,
+		Customer: ref.Of(Customer{Name: "bob"}),
+		Priority: opt.Of(Level(2)),
+		Timeout:  time.Minute,
+		Lines:    []Line{{SKU: "a", Qty: 3}},
+		Extras:   map[string]*Line{"none": nil},
+		Counts:   map[int]int{7: 49},
+		Discount: opt.Of(ref.Of(0.1)),
+		Internal: "secret",
+	}
+
+	res, err := mapstruct.Encode(&order)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"id":       int64(42),
+		"customer": map[string]any{"name": "bob"},
+		"priority": Level(2),
+		"timeout":  time.Minute,
+		"created":  time.Time{},
+		"lines":    []any{map[string]any{"sku": "a", "qty": uint8(3), "price": 0.0}},
+		"extras":   map[string]any{"none": nil},
+		"counts":   map[string]any{"7": 49},
+		"tags":     []any{"", ""},
+		"discount": 0.1,
+	}, res)
+
+	var decoded Order
+	require.NoError(t, mapstruct.Decode(res, &decoded))
+
+	order.Internal = ""
+	require.Equal(t, order, decoded, "round trip")
+}
+
+func TestEncodeFieldAddr(t *testing.T) {
+	t.Parallel()
+
+	type Counter struct {
+		Hits int  `json:"hits"`
+		Last *int `json:"last"`
+	}
+
+	type Stats struct {
+		Counter *Counter `json:"counter"`
+	}
+
+	counter := &Counter{Hits: 1}
+	counter.Last = &counter.Hits // same address as counter, but not a cycle
+
+	res, err := mapstruct.Encode(Stats{Counter: counter})
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"counter": map[string]any{"hits": 1, "last": 1}}, res)
+}
+
+func TestEncodeErr(t *testing.T) {
+	t.Parallel()
+
+	_, err := mapstruct.Encode(Order{})
+	require.ErrorIs(t, err, mapstruct.ErrRequired)
+	require.ErrorContains(t, err, "Customer")
+
+	type Node struct {
+		Next *Node
+	}
+
+	loop := &Node{}
+	loop.Next = loop
+
+	_, err = mapstruct.Encode(loop)
+	require.ErrorIs(t, err, mapstruct.ErrCycle)
+
+	_, err = mapstruct.Encode(42)
+	require.ErrorIs(t, err, mapstruct.ErrInvalidSource)
+}
@@ -0,0 +1,46 @@
+// Package maybe lets functions accept any kind of optional value: *T, opt.Opt[T] and ref.Ref[T].
+//
+// The Apply functions take any Maybe implementations, pointers are adapted with Ptr,
+// so any mix of kinds feeds one function without conversions:
+//
+//	total := maybe.Apply2(maybe.Ptr(price), quantity, multiply) // price *int, quantity opt.Opt[int]
+//
+// Go calls methods of type parameters through a dictionary and does not inline them, so the Apply functions
+// are not inlined either, see BenchmarkApply2 in the test package for the cost compared to the per-kind variants.
+package maybe
+
+import (
+	"github.com/sr9000/go-ptr-tools/opt"
+	"github.com/sr9000/go-ptr-tools/ref"
+)
+
+// Maybe is an optional value: Get returns the value and whether it is present.
+type Maybe[T any] interface {
+	Get() (T, bool)
+}
+
+var (
+	_ Maybe[int] = (*opt.Opt[int])(nil)
+	_ Maybe[int] = (*ref.Ref[int])(nil)
+	_ Maybe[int] = (*Value[int])(nil)
+)
+
+// Value is an optional value adapted from a pointer, the zero value is missing.
+type Value[T any] struct {
+	val T
+	ok  bool
+}
+
+// Ptr adapts the pointer to Value, the value is present when the pointer is not nil.
+func Ptr[T any](ptr *T) (v Value[T]) {
+	if ptr != nil {
+		return Value[T]{val: *ptr, ok: true}
+	}
+
+	return
+}
+
+// Get returns the value and true, or the zero value and false if it is missing.
+func (v Value[T]) Get() (T, bool) {
+	return v.val, v.ok
+}
@@ -0,0 +1,111 @@
+package maybe_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sr9000/go-ptr-tools/maybe"
+	"github.com/sr9000/go-ptr-tools/opt"
+	"github.com/sr9000/go-ptr-tools/ptr"
+	"github.com/sr9000/go-ptr-tools/ref"
+)
+
+func add(a, b int) int { return a + b }
+
+func TestPtr(t *testing.T) {
+	t.Parallel()
+
+	v, ok := maybe.Ptr(ptr.Of(1)).Get()
+	require.True(t, ok)
+	require.Equal(t, 1, v)
+
+	v, ok = maybe.Ptr[int](nil).Get()
+	require.False(t, ok)
+	require.Zero(t, v)
+}
+
+func TestApply(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		a        *int
+		b        opt.Opt[int]
+		c        int
+		expected opt.Opt[int]
+	}{
+		{"all kinds", ptr.Of(1), opt.Of(2), 3, opt.Of(6)},
+		{"nil ptr", nil, opt.Of(2), 3, opt.Opt[int]{}},
+		{"missing opt", ptr.Of(1), opt.Opt[int]{}, 3, opt.Opt[int]{}},
+	}
+
+	sum := func(a, b, c int) int { return a + b + c }
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.expected, maybe.Apply3(maybe.Ptr(tt.a), tt.b, ref.Of(tt.c), sum))
+		})
+	}
+}
+
+func TestApplyVariants(t *testing.T) {
+	t.Parallel()
+
+	t.Run("void", func(t *testing.T) {
+		t.Parallel()
+
+		var got []int
+
+		maybe.ApplyVoid(ref.Of(1), func(v int) { got = append(got, v) })
+		maybe.ApplyVoid(maybe.Ptr[int](nil), func(v int) { got = append(got, v) })
+		require.Equal(t, []int{1}, got)
+	})
+
+	t.Run("ctx err", func(t *testing.T) {
+		t.Parallel()
+
+		errEmpty := errors.New("empty")
+		parse := func(_ context.Context, s string) (int, error) {
+			if s == "" {
+				return 0, errEmpty
+			}
+
+			return strconv.Atoi(s)
+		}
+
+		n, err := maybe.ApplyCtxErr(t.Context(), opt.Of("42"), parse)
+		require.NoError(t, err)
+		require.Equal(t, opt.Of(42), n)
+
+		_, err = maybe.ApplyCtxErr(t.Context(), ref.Of(""), parse)
+		require.ErrorIs(t, err, errEmpty)
+
+		n, err = maybe.ApplyCtxErr(t.Context(), maybe.Ptr[string](nil), parse)
+		require.NoError(t, err)
+		require.True(t, n.IsMissing())
+	})
+
+	t.Run("multiple results", func(t *testing.T) {
+		t.Parallel()
+
+		q, r := maybe.Apply22(ref.Of(7), maybe.Ptr(ptr.Of(2)), func(a, b int) (int, int) { return a / b, a % b })
+		require.Equal(t, opt.Of(3), q)
+		require.Equal(t, opt.Of(1), r)
+	})
+}
+
+func TestApplyAllocs(t *testing.T) { //nolint:paralleltest // AllocsPerRun panics in parallel tests
+	a, b, c := ptr.Of(1), opt.Of(2), ref.Of(3)
+	sum := func(x, y, z int) int { return x + y + z }
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = maybe.Apply3(maybe.Ptr(a), b, c, sum)
+	})
+	require.Zero(t, allocs)
+}
@@ -0,0 +1,317 @@
+// Code generated by generate_monad.go; DO NOT EDIT.
+package opt
+
+func Chain[T1, R any](
+	t1 Opt[T1],
+	f1 func(t1 *T1) Opt[R],
+) (r Opt[R]) {
+	if !t1.ok {
+		return
+	}
+
+	return f1(&t1.val)
+}
+
+func Chain2[T1, T2, R any](
+	t1 Opt[T1],
+	f1 func(t1 *T1) Opt[T2],
+	f2 func(t2 *T2) Opt[R],
+) (r Opt[R]) {
+	if !t1.ok {
+		return
+	}
+
+	t2 := f1(&t1.val)
+	if !t2.ok {
+		return
+	}
+
+	return f2(&t2.val)
+}
+
+func Chain3[T1, T2, T3, R any](
+	t1 Opt[T1],
+	f1 func(t1 *T1) Opt[T2],
+	f2 func(t2 *T2) Opt[T3],
+	f3 func(t3 *T3) Opt[R],
+) (r Opt[R]) {
+	if !t1.ok {
+		return
+	}
+
+	t2 := f1(&t1.val)
+	if !t2.ok {
+		return
+	}
+
+	t3 := f2(&t2.val)
+	if !t3.ok {
+		return
+	}
+
+	return f3(&t3.val)
+}
+
+func Chain4[T1, T2, T3, T4, R any](
+	t1 Opt[T1],
+	f1 func(t1 *T1) Opt[T2],
+	f2 func(t2 *T2) Opt[T3],
+	f3 func(t3 *T3) Opt[T4],
+	f4 func(t4 *T4) Opt[R],
+) (r Opt[R]) {
+	if !t1.ok {
+		return
+	}
+
+	t2 := f1(&t1.val)
+	if !t2.ok {
+		return
+	}
+
+	t3 := f2(&t2.val)
+	if !t3.ok {
+		return
+	}
+
+	t4 := f3(&t3.val)
+	if !t4.ok {
+		return
+	}
+
+	return f4(&t4.val)
+}
+
+func Chain5[T1, T2, T3, T4, T5, R any](
+	t1 Opt[T1],
+	f1 func(t1 *T1) Opt[T2],
+	f2 func(t2 *T2) Opt[T3],
+	f3 func(t3 *T3) Opt[T4],
+	f4 func(t4 *T4) Opt[T5],
+	f5 func(t5 *T5) Opt[R],
+) (r Opt[R]) {
+	if !t1.ok {
+		return
+	}
+
+	t2 := f1(&t1.val)
+	if !t2.ok {
+		return
+	}
+
+	t3 := f2(&t2.val)
+	if !t3.ok {
+		return
+	}
+
+	t4 := f3(&t3.val)
+	if !t4.ok {
+		return
+	}
+
+	t5 := f4(&t4.val)
+	if !t5.ok {
+		return
+	}
+
+	return f5(&t5.val)
+}
+
+func Chain6[T1, T2, T3, T4, T5, T6, R any](
+	t1 Opt[T1],
+	f1 func(t1 *T1) Opt[T2],
+	f2 func(t2 *T2) Opt[T3],
+	f3 func(t3 *T3) Opt[T4],
+	f4 func(t4 *T4) Opt[T5],
+	f5 func(t5 *T5) Opt[T6],
+	f6 func(t6 *T6) Opt[R],
+) (r Opt[R]) {
+	if !t1.ok {
+		return
+	}
+
+	t2 := f1(&t1.val)
+	if !t2.ok {
+		return
+	}
+
+	t3 := f2(&t2.val)
+	if !t3.ok {
+		return
+	}
+
+	t4 := f3(&t3.val)
+	if !t4.ok {
+		return
+	}
+
+	t5 := f4(&t4.val)
+	if !t5.ok {
+		return
+	}
+
+	t6 := f5(&t5.val)
+	if !t6.ok {
+		return
+	}
+
+	return f6(&t6.val)
+}
+
+func Chain7[T1, T2, T3, T4, T5, T6, T7, R any](
+	t1 Opt[T1],
+	f1 func(t1 *T1) Opt[T2],
+	f2 func(t2 *T2) Opt[T3],
+	f3 func(t3 *T3) Opt[T4],
+	f4 func(t4 *T4) Opt[T5],
+	f5 func(t5 *T5) Opt[T6],
+	f6 func(t6 *T6) Opt[T7],
+	f7 func(t7 *T7) Opt[R],
+) (r Opt[R]) {
+	if !t1.ok {
+		return
+	}
+
+	t2 := f1(&t1.val)
+	if !t2.ok {
+		return
+	}
+
+	t3 := f2(&t2.val)
+	if !t3.ok {
+		return
+	}
+
+	t4 := f3(&t3.val)
+	if !t4.ok {
+		return
+	}
+
+	t5 := f4(&t4.val)
+	if !t5.ok {
+		return
+	}
+
+	t6 := f5(&t5.val)
+	if !t6.ok {
+		return
+	}
+
+	t7 := f6(&t6.val)
+	if !t7.ok {
+		return
+	}
+
+	return f7(&t7.val)
+}
+
+func Chain8[T1, T2, T3, T4, T5, T6, T7, T8, R any](
+	t1 Opt[T1],
+	f1 func(t1 *T1) Opt[T2],
+	f2 func(t2 *T2) Opt[T3],
+	f3 func(t3 *T3) Opt[T4],
+	f4 func(t4 *T4) Opt[T5],
+	f5 func(t5 *T5) Opt[T6],
+	f6 func(t6 *T6) Opt[T7],
+	f7 func(t7 *T7) Opt[T8],
+	f8 func(t8 *T8) Opt[R],
+) (r Opt[R]) {
+	if !t1.ok {
+		return
+	}
+
+	t2 := f1(&t1.val)
+	if !t2.ok {
+		return
+	}
+
+	t3 := f2(&t2.val)
+	if !t3.ok {
+		return
+	}
+
+	t4 := f3(&t3.val)
+	if !t4.ok {
+		return
+	}
+
+	t5 := f4(&t4.val)
+	if !t5.ok {
+		return
+	}
+
+	t6 := f5(&t5.val)
+	if !t6.ok {
+		return
+	}
+
+	t7 := f6(&t6.val)
+	if !t7.ok {
+		return
+	}
+
+	t8 := f7(&t7.val)
+	if !t8.ok {
+		return
+	}
+
+	return f8(&t8.val)
+}
+
+func Chain9[T1, T2, T3, T4, T5, T6, T7, T8, T9, R any](
+	t1 Opt[T1],
+	f1 func(t1 *T1) Opt[T2],
+	f2 func(t2 *T2) Opt[T3],
+	f3 func(t3 *T3) Opt[T4],
+	f4 func(t4 *T4) Opt[T5],
+	f5 func(t5 *T5) Opt[T6],
+	f6 func(t6 *T6) Opt[T7],
+	f7 func(t7 *T7) Opt[T8],
+	f8 func(t8 *T8) Opt[T9],
+	f9 func(t9 *T9) Opt[R],
+) (r Opt[R]) {
+	if !t1.ok {
+		return
+	}
+
+	t2 := f1(&t1.val)
+	if !t2.ok {
+		return
+	}
+
+	t3 := f2(&t2.val)
+	if !t3.ok {
+		return
+	}
+
+	t4 := f3(&t3.val)
+	if !t4.ok {
+		return
+	}
+
+	t5 := f4(&t4.val)
+	if !t5.ok {
+		return
+	}
+
+	t6 := f5(&t5.val)
+	if !t6.ok {
+		return
+	}
+
+	t7 := f6(&t6.val)
+	if !t7.ok {
+		return
+	}
+
+	t8 := f7(&t7.val)
+	if !t8.ok {
+		return
+	}
+
+	t9 := f8(&t8.val)
+	if !t9.ok {
+		return
+	}
+
+	return f9(&t9.val)
+}
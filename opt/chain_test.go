@@ -0,0 +1,43 @@
+package opt_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sr9000/go-ptr-tools/opt"
+)
+
+type (
+	Account struct{ Profile opt.Opt[Profile] }
+	Profile struct{ Address opt.Opt[Address] }
+	Address struct{ City string }
+)
+
+func TestChain(t *testing.T) {
+	t.Parallel()
+
+	profile := func(a *Account) opt.Opt[Profile] { return a.Profile }
+	address := func(p *Profile) opt.Opt[Address] { return p.Address }
+	city := func(a *Address) opt.Opt[string] { return opt.FromZero(a.City) }
+
+	tests := []struct {
+		name     string
+		account  opt.Opt[Account]
+		expected opt.Opt[string]
+	}{
+		{"full path", opt.Of(Account{opt.Of(Profile{opt.Of(Address{"Oslo"})})}), opt.Of("Oslo")},
+		{"empty city", opt.Of(Account{opt.Of(Profile{opt.Of(Address{})})}), opt.Opt[string]{}},
+		{"missing address", opt.Of(Account{opt.Of(Profile{})}), opt.Opt[string]{}},
+		{"missing profile", opt.Of(Account{}), opt.Opt[string]{}},
+		{"missing root", opt.Opt[Account]{}, opt.Opt[string]{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.expected, opt.Chain3(tt.account, profile, address, city))
+		})
+	}
+}
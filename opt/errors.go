@@ -0,0 +1,9 @@
+package opt
+
+import "github.com/sr9000/go-ptr-tools/internal/fieldpath"
+
+var (
+	ErrTypeMismatch = fieldpath.ErrTypeMismatch
+	ErrPathSyntax   = fieldpath.ErrSyntax
+	ErrPathNotFound = fieldpath.ErrNotFound
+)
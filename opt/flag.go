@@ -0,0 +1,74 @@
+package opt
+
+import (
+	"flag"
+	"fmt"
+)
+
+// optionalFlag is implemented by flag values registered with FlagVar (both opt and ptr packages).
+type optionalFlag interface {
+	flag.Value
+	IsOptionalFlag() bool
+}
+
+type flagValue[T any] struct {
+	opt   *Opt[T]
+	parse func(string) (T, error)
+}
+
+// FlagVar defines an optional flag with specified name and usage string.
+// The target Opt is left untouched until the flag is seen on the command line,
+// so `--timeout=0` and omitted `--timeout` are distinguishable.
+// The argument is converted by the parse function, see package parse for built-in parsers.
+func FlagVar[T any](fs *flag.FlagSet, o *Opt[T], name, usage string, parse func(string) (T, error)) {
+	fs.Var(&flagValue[T]{opt: o, parse: parse}, name, usage)
+}
+
+// Visited returns names of optional flags (registered via opt.FlagVar or ptr.FlagVar)
+// that were set on the command line, in lexicographical order.
+func Visited(fs *flag.FlagSet) (names []string) {
+	fs.Visit(func(f *flag.Flag) {
+		if v, ok := f.Value.(optionalFlag); ok && v.IsOptionalFlag() {
+			names = append(names, f.Name)
+		}
+	})
+
+	return
+}
+
+// Set parses the argument and stores the result into the target Opt.
+func (f *flagValue[T]) Set(s string) error {
+	v, err := f.parse(s)
+	if err != nil {
+		return err
+	}
+
+	*f.opt = Of(v)
+
+	return nil
+}
+
+// String returns the formatted value, or an empty string if it is missing.
+func (f *flagValue[T]) String() string {
+	if f.opt == nil {
+		return "" // zero flagValue is used by flag.PrintDefaults
+	}
+
+	if v, ok := f.opt.Get(); ok {
+		return fmt.Sprint(v)
+	}
+
+	return ""
+}
+
+// IsBoolFlag allows to use boolean optional flags without an argument, e.g. `--verbose`.
+func (f *flagValue[T]) IsBoolFlag() bool {
+	_, ok := any(*new(T)).(bool)
+
+	return ok
+}
+
+// IsOptionalFlag marks the value as an optional flag for Visited.
+func (f *flagValue[T]) IsOptionalFlag() bool {
+	return true
+}
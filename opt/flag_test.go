@@ -0,0 +1,111 @@
+package opt_test
+
+import (
+	"flag"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sr9000/go-ptr-tools/opt"
+	"github.com/sr9000/go-ptr-tools/parse"
+	"github.com/sr9000/go-ptr-tools/ptr"
+)
+
+func newFlagSet() *flag.FlagSet {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+
+	return fs
+}
+
+func TestFlagVar(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		args     []string
+		expected opt.Opt[time.Duration]
+	}{
+		{"omitted", []string{}, opt.Opt[time.Duration]{}},
+		{"zero", []string{"--timeout=0"}, opt.Of(time.Duration(0))},
+		{"value", []string{"--timeout", "5s"}, opt.Of(5 * time.Second)},
+		{"last wins", []string{"--timeout=1s", "--timeout=2s"}, opt.Of(2 * time.Second)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var timeout opt.Opt[time.Duration]
+
+			fs := newFlagSet()
+			opt.FlagVar(fs, &timeout, "timeout", "request timeout", parse.Duration)
+
+			require.NoError(t, fs.Parse(tt.args))
+			require.Equal(t, tt.expected, timeout)
+		})
+	}
+}
+
+func TestFlagVarErr(t *testing.T) {
+	t.Parallel()
+
+	var port opt.Opt[int]
+
+	fs := newFlagSet()
+	opt.FlagVar(fs, &port, "port", "listen port", parse.Int)
+
+	require.Error(t, fs.Parse([]string{"--port=http"}))
+	require.True(t, port.IsMissing())
+}
+
+func TestFlagVarBool(t *testing.T) {
+	t.Parallel()
+
+	var verbose, color opt.Opt[bool]
+
+	fs := newFlagSet()
+	opt.FlagVar(fs, &verbose, "verbose", "verbose output", parse.Bool)
+	opt.FlagVar(fs, &color, "color", "colored output", parse.Bool)
+
+	require.NoError(t, fs.Parse([]string{"--verbose", "--color=false"}))
+	require.Equal(t, opt.Of(true), verbose)
+	require.Equal(t, opt.Of(false), color)
+}
+
+func TestVisited(t *testing.T) {
+	t.Parallel()
+
+	var (
+		name    opt.Opt[string]
+		retries opt.Opt[int]
+		port    *int
+		debug   bool
+	)
+
+	fs := newFlagSet()
+	opt.FlagVar(fs, &name, "name", "name", parse.String)
+	opt.FlagVar(fs, &retries, "retries", "retries", parse.Int)
+	ptr.FlagVar(fs, &port, "port", "port", parse.Int)
+	fs.BoolVar(&debug, "debug", false, "plain flag is not reported")
+
+	require.NoError(t, fs.Parse([]string{"--port=0", "--debug", "--name=x"}))
+	require.Equal(t, []string{"name", "port"}, opt.Visited(fs))
+}
+
+func TestFlagVarDefaults(t *testing.T) {
+	t.Parallel()
+
+	var timeout opt.Opt[time.Duration]
+
+	fs := newFlagSet()
+	opt.FlagVar(fs, &timeout, "timeout", "request timeout", parse.Duration)
+
+	require.Empty(t, fs.Lookup("timeout").Value.String())
+	require.NotPanics(t, fs.PrintDefaults)
+
+	require.NoError(t, fs.Parse([]string{"--timeout=1m"}))
+	require.Equal(t, "1m0s", fs.Lookup("timeout").Value.String())
+}
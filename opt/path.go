@@ -0,0 +1,38 @@
+package opt
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/sr9000/go-ptr-tools/internal/fieldpath"
+)
+
+// GetPath returns the value of type T at the path within root, e.g. "User.Profile.Address.City" or "Items[2].Price".
+// See ptr.GetPath for the path syntax.
+//   - If some pointer, interface, map key or Opt on the way is nil or missing, it returns an empty Opt and no error.
+//   - If the path is malformed, it returns ErrPathSyntax.
+//   - If the path does not fit the value (unknown field, index out of range), it returns ErrPathNotFound.
+//   - If the value is not assignable to T, it returns ErrTypeMismatch.
+func GetPath[T any](root any, path string) (o Opt[T], err error) {
+	if root == nil {
+		return
+	}
+
+	walker := fieldpath.Walker{Opt: fieldpath.OptHooks{
+		IsOpt:    IsOptType,
+		ElemType: ElemType,
+		Get:      ReflectGet,
+		Set:      ReflectSet,
+	}}
+
+	v, ok, err := walker.Get(reflect.ValueOf(root), path)
+	if !ok || err != nil {
+		return
+	}
+
+	if !v.Type().AssignableTo(reflect.TypeFor[T]()) {
+		return o, fmt.Errorf("%w: cannot assign %s to %s", ErrTypeMismatch, v.Type(), reflect.TypeFor[T]())
+	}
+
+	return Of(v.Interface().(T)), nil //nolint:forcetypeassert // checked above
+}
@@ -0,0 +1,40 @@
+package opt_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sr9000/go-ptr-tools/opt"
+)
+
+func TestGetPath(t *testing.T) {
+	t.Parallel()
+
+	account := &Account{Profile: opt.Of(Profile{Address: opt.Of(Address{City: "Oslo"})})}
+
+	city, err := opt.GetPath[string](account, "Profile.Address.City")
+	require.NoError(t, err)
+	require.Equal(t, opt.Of("Oslo"), city)
+
+	addr, err := opt.GetPath[Address](account, "Profile.Address")
+	require.NoError(t, err)
+	require.Equal(t, opt.Of(Address{City: "Oslo"}), addr)
+
+	missing, err := opt.GetPath[string](&Account{}, "Profile.Address.City")
+	require.NoError(t, err)
+	require.True(t, missing.IsMissing())
+
+	_, err = opt.GetPath[int](account, "Profile.Address.City")
+	require.ErrorIs(t, err, opt.ErrTypeMismatch)
+
+	_, err = opt.GetPath[string](account, "Profile.Adress.City")
+	require.ErrorIs(t, err, opt.ErrPathNotFound)
+
+	_, err = opt.GetPath[string](account, "Profile..City")
+	require.ErrorIs(t, err, opt.ErrPathSyntax)
+
+	none, err := opt.GetPath[string](nil, "Profile")
+	require.NoError(t, err)
+	require.True(t, none.IsMissing())
+}
@@ -0,0 +1,58 @@
+package opt
+
+import (
+	"reflect"
+	"strings"
+)
+
+// reflected is implemented by every Opt[T] and lets reflection-based tools handle Opt without knowing T.
+type reflected interface {
+	reflectGet() (reflect.Value, bool)
+	reflectElem() reflect.Type
+}
+
+// reflectedSetter is implemented by every *Opt[T].
+type reflectedSetter interface {
+	reflectSet(val reflect.Value)
+}
+
+//nolint:gochecknoglobals // package path of reflected Opt types
+var pkgPath = reflect.TypeFor[Opt[struct{}]]().PkgPath()
+
+// IsOptType reports whether t is an instantiation of Opt.
+// Structs embedding an Opt are not: they have the promoted methods of Opt, but other fields too.
+func IsOptType(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && t.PkgPath() == pkgPath && strings.HasPrefix(t.Name(), "Opt[")
+}
+
+// ElemType returns T for the reflected type of Opt[T].
+// It panics if t is not an Opt, see IsOptType.
+func ElemType(t reflect.Type) reflect.Type {
+	return reflect.Zero(t).Interface().(reflected).reflectElem() //nolint:forcetypeassert // documented panic
+}
+
+// ReflectGet returns the reflected value and a boolean indicating if the value is present.
+// It panics if v does not hold an Opt, see IsOptType.
+func ReflectGet(v reflect.Value) (reflect.Value, bool) {
+	return v.Interface().(reflected).reflectGet() //nolint:forcetypeassert // documented panic
+}
+
+// ReflectSet stores val into the addressable reflected Opt dst and marks it present.
+// Use dst.SetZero() to make it empty.
+// It panics if dst does not hold an Opt or val is not assignable to its value type.
+func ReflectSet(dst, val reflect.Value) {
+	dst.Addr().Interface().(reflectedSetter).reflectSet(val) //nolint:forcetypeassert // documented panic
+}
+
+func (o Opt[T]) reflectGet() (reflect.Value, bool) {
+	return reflect.ValueOf(&o.val).Elem(), o.ok
+}
+
+func (o Opt[T]) reflectElem() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
+func (o *Opt[T]) reflectSet(val reflect.Value) {
+	reflect.ValueOf(&o.val).Elem().Set(val)
+	o.ok = true
+}
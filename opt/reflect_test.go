@@ -0,0 +1,50 @@
+package opt_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sr9000/go-ptr-tools/opt"
+)
+
+// Limit embeds an Opt, so it has the methods of Opt, but it is not an Opt.
+type Limit struct {
+	opt.Opt[int]
+
+	Unit string
+}
+
+func TestIsOptType(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, opt.IsOptType(reflect.TypeFor[opt.Opt[int]]()))
+	require.True(t, opt.IsOptType(reflect.TypeFor[opt.Opt[[]string]]()))
+	require.False(t, opt.IsOptType(reflect.TypeFor[*opt.Opt[int]]()))
+	require.False(t, opt.IsOptType(reflect.TypeFor[int]()))
+	require.False(t, opt.IsOptType(reflect.TypeFor[struct{}]()))
+	require.False(t, opt.IsOptType(reflect.TypeFor[Limit]()))
+	require.Equal(t, reflect.TypeFor[string](), opt.ElemType(reflect.TypeFor[opt.Opt[string]]()))
+}
+
+func TestReflectGetSet(t *testing.T) {
+	t.Parallel()
+
+	var o opt.Opt[int]
+
+	rv := reflect.ValueOf(&o).Elem()
+
+	_, ok := opt.ReflectGet(rv)
+	require.False(t, ok)
+
+	opt.ReflectSet(rv, reflect.ValueOf(42))
+	require.Equal(t, opt.Of(42), o)
+
+	val, ok := opt.ReflectGet(rv)
+	require.True(t, ok)
+	require.Equal(t, 42, val.Interface())
+
+	rv.SetZero()
+	require.True(t, o.IsMissing())
+}
@@ -0,0 +1,70 @@
+// Package parse provides string parsers for common types.
+// Parsers are shared by helpers that read optional values from text sources,
+// such as command-line flags (opt.FlagVar, ptr.FlagVar) and environment variables (env.Load).
+package parse
+
+import (
+	"encoding"
+	"strconv"
+	"time"
+)
+
+// String returns the input string as is, it never fails.
+func String(s string) (string, error) {
+	return s, nil
+}
+
+// Bool parses a boolean value using strconv.ParseBool.
+func Bool(s string) (bool, error) {
+	return strconv.ParseBool(s)
+}
+
+// Int parses a decimal, hex (0x), octal (0o) or binary (0b) integer.
+func Int(s string) (int, error) {
+	v, err := strconv.ParseInt(s, 0, strconv.IntSize)
+
+	return int(v), err
+}
+
+// Int64 parses a decimal, hex (0x), octal (0o) or binary (0b) 64-bit integer.
+func Int64(s string) (int64, error) {
+	return strconv.ParseInt(s, 0, 64)
+}
+
+// Uint parses a decimal, hex (0x), octal (0o) or binary (0b) unsigned integer.
+func Uint(s string) (uint, error) {
+	v, err := strconv.ParseUint(s, 0, strconv.IntSize)
+
+	return uint(v), err
+}
+
+// Uint64 parses a decimal, hex (0x), octal (0o) or binary (0b) 64-bit unsigned integer.
+func Uint64(s string) (uint64, error) {
+	return strconv.ParseUint(s, 0, 64)
+}
+
+// Float64 parses a floating-point number using strconv.ParseFloat.
+func Float64(s string) (float64, error) {
+	return strconv.ParseFloat(s, 64)
+}
+
+// Duration parses a duration string using time.ParseDuration, e.g. "1h30m" or "100ms".
+func Duration(s string) (time.Duration, error) {
+	return time.ParseDuration(s)
+}
+
+// Text parses a value of any type which pointer implements encoding.TextUnmarshaler,
+// e.g. time.Time, net/netip.Addr or big.Int.
+func Text[T any, PT interface {
+	*T
+	encoding.TextUnmarshaler
+}](s string) (T, error) {
+	var v T
+
+	err := PT(&v).UnmarshalText([]byte(s))
+	if err != nil {
+		return *new(T), err
+	}
+
+	return v, nil
+}
@@ -0,0 +1,77 @@
+package parse_test
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sr9000/go-ptr-tools/parse"
+)
+
+func TestParsers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		parse    func(string) (any, error)
+		input    string
+		expected any
+	}{
+		{"string", wrap(parse.String), "text", "text"},
+		{"bool", wrap(parse.Bool), "true", true},
+		{"int", wrap(parse.Int), "-42", -42},
+		{"int hex", wrap(parse.Int), "0x2a", 42},
+		{"int64", wrap(parse.Int64), "42", int64(42)},
+		{"uint", wrap(parse.Uint), "42", uint(42)},
+		{"uint64", wrap(parse.Uint64), "0b101010", uint64(42)},
+		{"float64", wrap(parse.Float64), "4.2", 4.2},
+		{"duration", wrap(parse.Duration), "1m30s", 90 * time.Second},
+		{"text", wrap(parse.Text[netip.Addr]), "127.0.0.1", netip.MustParseAddr("127.0.0.1")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			v, err := tt.parse(tt.input)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, v)
+		})
+	}
+}
+
+func TestParsersErr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		parse func(string) (any, error)
+		input string
+	}{
+		{"bool", wrap(parse.Bool), "yes"},
+		{"int", wrap(parse.Int), "4.2"},
+		{"int64", wrap(parse.Int64), ""},
+		{"uint", wrap(parse.Uint), "-1"},
+		{"uint64", wrap(parse.Uint64), "x"},
+		{"float64", wrap(parse.Float64), "pi"},
+		{"duration", wrap(parse.Duration), "5"},
+		{"text", wrap(parse.Text[netip.Addr]), "localhost"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := tt.parse(tt.input)
+			require.Error(t, err)
+		})
+	}
+}
+
+func wrap[T any](fn func(string) (T, error)) func(string) (any, error) {
+	return func(s string) (any, error) {
+		return fn(s)
+	}
+}
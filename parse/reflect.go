@@ -0,0 +1,86 @@
+package parse
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+var ErrUnsupportedType = errors.New("unsupported type")
+
+// Value parses a string into a new value of the reflected type t.
+// Types implementing encoding.TextUnmarshaler (by pointer) are parsed with it,
+// time.Duration is parsed with time.ParseDuration,
+// strings, booleans, integers and floats are parsed with strconv.
+func Value(t reflect.Type, s string) (reflect.Value, error) {
+	val := reflect.New(t).Elem()
+
+	if u, ok := val.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		err := u.UnmarshalText([]byte(s))
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		return val, nil
+	}
+
+	if t == reflect.TypeFor[time.Duration]() {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		val.SetInt(int64(d))
+
+		return val, nil
+	}
+
+	err := setKind(val, s)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
+	return val, nil
+}
+
+func setKind(val reflect.Value, s string) error {
+	switch val.Kind() {
+	case reflect.String:
+		val.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+
+		val.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 0, val.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		val.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u, err := strconv.ParseUint(s, 0, val.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		val.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, val.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		val.SetFloat(f)
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedType, val.Type())
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package parse_test
+
+import (
+	"net/netip"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sr9000/go-ptr-tools/parse"
+)
+
+type level uint8
+
+func TestValue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected any
+	}{
+		{"string", "text", "text"},
+		{"bool", "1", true},
+		{"int8", "-8", int8(-8)},
+		{"int", "0o17", 15},
+		{"named uint", "3", level(3)},
+		{"float32", "0.5", float32(0.5)},
+		{"duration", "2h", 2 * time.Hour},
+		{"text", "::1", netip.MustParseAddr("::1")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			v, err := parse.Value(reflect.TypeOf(tt.expected), tt.input)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, v.Interface())
+		})
+	}
+}
+
+func TestValueErr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		typ   reflect.Type
+		input string
+	}{
+		{"overflow", reflect.TypeFor[int8](), "300"},
+		{"negative uint", reflect.TypeFor[uint](), "-1"},
+		{"bad bool", reflect.TypeFor[bool](), "on"},
+		{"bad float", reflect.TypeFor[float64](), "1,5"},
+		{"bad duration", reflect.TypeFor[time.Duration](), "1d"},
+		{"bad text", reflect.TypeFor[netip.Addr](), "::x"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := parse.Value(tt.typ, tt.input)
+			require.Error(t, err)
+		})
+	}
+
+	t.Run("unsupported", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := parse.Value(reflect.TypeFor[[]int](), "1,2")
+		require.ErrorIs(t, err, parse.ErrUnsupportedType)
+	})
+}
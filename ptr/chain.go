@@ -0,0 +1,317 @@
+// Code generated by generate_monad.go; DO NOT EDIT.
+package ptr
+
+func Chain[T1, R any](
+	t1 *T1,
+	f1 func(t1 *T1) *R,
+) (r *R) {
+	if t1 == nil {
+		return
+	}
+
+	return f1(t1)
+}
+
+func Chain2[T1, T2, R any](
+	t1 *T1,
+	f1 func(t1 *T1) *T2,
+	f2 func(t2 *T2) *R,
+) (r *R) {
+	if t1 == nil {
+		return
+	}
+
+	t2 := f1(t1)
+	if t2 == nil {
+		return
+	}
+
+	return f2(t2)
+}
+
+func Chain3[T1, T2, T3, R any](
+	t1 *T1,
+	f1 func(t1 *T1) *T2,
+	f2 func(t2 *T2) *T3,
+	f3 func(t3 *T3) *R,
+) (r *R) {
+	if t1 == nil {
+		return
+	}
+
+	t2 := f1(t1)
+	if t2 == nil {
+		return
+	}
+
+	t3 := f2(t2)
+	if t3 == nil {
+		return
+	}
+
+	return f3(t3)
+}
+
+func Chain4[T1, T2, T3, T4, R any](
+	t1 *T1,
+	f1 func(t1 *T1) *T2,
+	f2 func(t2 *T2) *T3,
+	f3 func(t3 *T3) *T4,
+	f4 func(t4 *T4) *R,
+) (r *R) {
+	if t1 == nil {
+		return
+	}
+
+	t2 := f1(t1)
+	if t2 == nil {
+		return
+	}
+
+	t3 := f2(t2)
+	if t3 == nil {
+		return
+	}
+
+	t4 := f3(t3)
+	if t4 == nil {
+		return
+	}
+
+	return f4(t4)
+}
+
+func Chain5[T1, T2, T3, T4, T5, R any](
+	t1 *T1,
+	f1 func(t1 *T1) *T2,
+	f2 func(t2 *T2) *T3,
+	f3 func(t3 *T3) *T4,
+	f4 func(t4 *T4) *T5,
+	f5 func(t5 *T5) *R,
+) (r *R) {
+	if t1 == nil {
+		return
+	}
+
+	t2 := f1(t1)
+	if t2 == nil {
+		return
+	}
+
+	t3 := f2(t2)
+	if t3 == nil {
+		return
+	}
+
+	t4 := f3(t3)
+	if t4 == nil {
+		return
+	}
+
+	t5 := f4(t4)
+	if t5 == nil {
+		return
+	}
+
+	return f5(t5)
+}
+
+func Chain6[T1, T2, T3, T4, T5, T6, R any](
+	t1 *T1,
+	f1 func(t1 *T1) *T2,
+	f2 func(t2 *T2) *T3,
+	f3 func(t3 *T3) *T4,
+	f4 func(t4 *T4) *T5,
+	f5 func(t5 *T5) *T6,
+	f6 func(t6 *T6) *R,
+) (r *R) {
+	if t1 == nil {
+		return
+	}
+
+	t2 := f1(t1)
+	if t2 == nil {
+		return
+	}
+
+	t3 := f2(t2)
+	if t3 == nil {
+		return
+	}
+
+	t4 := f3(t3)
+	if t4 == nil {
+		return
+	}
+
+	t5 := f4(t4)
+	if t5 == nil {
+		return
+	}
+
+	t6 := f5(t5)
+	if t6 == nil {
+		return
+	}
+
+	return f6(t6)
+}
+
+func Chain7[T1, T2, T3, T4, T5, T6, T7, R any](
+	t1 *T1,
+	f1 func(t1 *T1) *T2,
+	f2 func(t2 *T2) *T3,
+	f3 func(t3 *T3) *T4,
+	f4 func(t4 *T4) *T5,
+	f5 func(t5 *T5) *T6,
+	f6 func(t6 *T6) *T7,
+	f7 func(t7 *T7) *R,
+) (r *R) {
+	if t1 == nil {
+		return
+	}
+
+	t2 := f1(t1)
+	if t2 == nil {
+		return
+	}
+
+	t3 := f2(t2)
+	if t3 == nil {
+		return
+	}
+
+	t4 := f3(t3)
+	if t4 == nil {
+		return
+	}
+
+	t5 := f4(t4)
+	if t5 == nil {
+		return
+	}
+
+	t6 := f5(t5)
+	if t6 == nil {
+		return
+	}
+
+	t7 := f6(t6)
+	if t7 == nil {
+		return
+	}
+
+	return f7(t7)
+}
+
+func Chain8[T1, T2, T3, T4, T5, T6, T7, T8, R any](
+	t1 *T1,
+	f1 func(t1 *T1) *T2,
+	f2 func(t2 *T2) *T3,
+	f3 func(t3 *T3) *T4,
+	f4 func(t4 *T4) *T5,
+	f5 func(t5 *T5) *T6,
+	f6 func(t6 *T6) *T7,
+	f7 func(t7 *T7) *T8,
+	f8 func(t8 *T8) *R,
+) (r *R) {
+	if t1 == nil {
+		return
+	}
+
+	t2 := f1(t1)
+	if t2 == nil {
+		return
+	}
+
+	t3 := f2(t2)
+	if t3 == nil {
+		return
+	}
+
+	t4 := f3(t3)
+	if t4 == nil {
+		return
+	}
+
+	t5 := f4(t4)
+	if t5 == nil {
+		return
+	}
+
+	t6 := f5(t5)
+	if t6 == nil {
+		return
+	}
+
+	t7 := f6(t6)
+	if t7 == nil {
+		return
+	}
+
+	t8 := f7(t7)
+	if t8 == nil {
+		return
+	}
+
+	return f8(t8)
+}
+
+func Chain9[T1, T2, T3, T4, T5, T6, T7, T8, T9, R any](
+	t1 *T1,
+	f1 func(t1 *T1) *T2,
+	f2 func(t2 *T2) *T3,
+	f3 func(t3 *T3) *T4,
+	f4 func(t4 *T4) *T5,
+	f5 func(t5 *T5) *T6,
+	f6 func(t6 *T6) *T7,
+	f7 func(t7 *T7) *T8,
+	f8 func(t8 *T8) *T9,
+	f9 func(t9 *T9) *R,
+) (r *R) {
+	if t1 == nil {
+		return
+	}
+
+	t2 := f1(t1)
+	if t2 == nil {
+		return
+	}
+
+	t3 := f2(t2)
+	if t3 == nil {
+		return
+	}
+
+	t4 := f3(t3)
+	if t4 == nil {
+		return
+	}
+
+	t5 := f4(t4)
+	if t5 == nil {
+		return
+	}
+
+	t6 := f5(t5)
+	if t6 == nil {
+		return
+	}
+
+	t7 := f6(t6)
+	if t7 == nil {
+		return
+	}
+
+	t8 := f7(t7)
+	if t8 == nil {
+		return
+	}
+
+	t9 := f8(t8)
+	if t9 == nil {
+		return
+	}
+
+	return f9(t9)
+}
@@ -0,0 +1,68 @@
+package ptr_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sr9000/go-ptr-tools/ptr"
+)
+
+type (
+	Request struct{ User *Account }
+	Account struct{ Profile *Profile }
+	Profile struct{ Address *Address }
+)
+
+func (r *Request) GetUser() *Account    { return r.User }
+func (a *Account) GetProfile() *Profile { return a.Profile }
+func (p *Profile) GetAddress() *Address { return p.Address }
+func (a *Address) GetStreet() *string   { return a.Street }
+func (a *Address) GetCity() *string     { return &a.City }
+
+func TestChain(t *testing.T) {
+	t.Parallel()
+
+	full := &Request{User: &Account{Profile: &Profile{Address: &Address{City: "Oslo"}}}}
+
+	tests := []struct {
+		name     string
+		req      *Request
+		expected *string
+	}{
+		{"full path", full, &full.User.Profile.Address.City},
+		{"nil root", nil, nil},
+		{"nil user", &Request{}, nil},
+		{"nil profile", &Request{User: &Account{}}, nil},
+		{"nil address", &Request{User: &Account{Profile: &Profile{}}}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			city := ptr.Chain4(tt.req, (*Request).GetUser, (*Account).GetProfile, (*Profile).GetAddress, (*Address).GetCity)
+			require.Same(t, tt.expected, city)
+		})
+	}
+}
+
+func TestChainLast(t *testing.T) {
+	t.Parallel()
+
+	addr := &Address{City: "Oslo"}
+	require.Nil(t, ptr.Chain(addr, (*Address).GetStreet))
+
+	addr.Street = ptr.Of("Main st.")
+	require.Same(t, addr.Street, ptr.Chain(addr, (*Address).GetStreet))
+
+	calls := 0
+	step := func(a *Address) *Address {
+		calls++
+
+		return a
+	}
+
+	require.Same(t, addr.Street, ptr.Chain9(addr, step, step, step, step, step, step, step, step, (*Address).GetStreet))
+	require.Equal(t, 8, calls)
+}
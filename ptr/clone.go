@@ -0,0 +1,147 @@
+package ptr
+
+import (
+	"reflect"
+
+	"github.com/sr9000/go-ptr-tools/internal/fieldpath"
+	"github.com/sr9000/go-ptr-tools/opt"
+	"github.com/sr9000/go-ptr-tools/ref"
+)
+
+// Clone returns a deep copy of the value: pointees, opt.Opt and ref.Ref values, slices, maps and interfaces
+// are copied recursively, so the copy shares no mutable state with the original.
+//   - Types defining the `Clone() T` method are copied with it.
+//   - Cycles are preserved: a pointer (or a map) met twice is copied once.
+//   - Unexported struct fields are copied as is (shallowly), as well as funcs and chans.
+func Clone[T any](v T) T {
+	var res T
+
+	c := cloner{visited: make(map[visitKey]reflect.Value)}
+	c.clone(reflect.ValueOf(&res).Elem(), reflect.ValueOf(&v).Elem())
+
+	return res
+}
+
+type visitKey struct {
+	addr uintptr
+	typ  reflect.Type
+}
+
+type cloner struct {
+	visited map[visitKey]reflect.Value
+}
+
+// clone deep copies src into the settable dst of the same type.
+func (c *cloner) clone(dst, src reflect.Value) {
+	switch {
+	case fieldpath.IsNilable(src.Kind()) && src.IsNil():
+		dst.SetZero() // a Clone method may not accept nil receivers
+	case hasClone(src.Type()) && src.CanInterface():
+		dst.Set(src.MethodByName("Clone").Call(nil)[0])
+	case opt.IsOptType(src.Type()):
+		val, ok := opt.ReflectGet(src)
+		if !ok {
+			dst.SetZero()
+
+			return
+		}
+
+		tmp := reflect.New(val.Type()).Elem()
+		c.clone(tmp, val)
+		opt.ReflectSet(dst, tmp)
+	case ref.IsRefType(src.Type()):
+		p := ref.ReflectPtr(src)
+		tmp := reflect.New(p.Type()).Elem()
+		c.clone(tmp, p)
+		ref.ReflectSet(dst, tmp)
+	default:
+		c.cloneKind(dst, src)
+	}
+}
+
+func (c *cloner) cloneKind(dst, src reflect.Value) {
+	switch src.Kind() {
+	case reflect.Pointer:
+		if c.reuse(dst, src) {
+			return
+		}
+
+		dst.Set(reflect.New(src.Type().Elem()))
+		c.visited[visitKey{src.Pointer(), src.Type()}] = dst
+		c.clone(dst.Elem(), src.Elem())
+	case reflect.Map:
+		if c.reuse(dst, src) {
+			return
+		}
+
+		dst.Set(reflect.MakeMapWithSize(src.Type(), src.Len()))
+		c.visited[visitKey{src.Pointer(), src.Type()}] = dst
+
+		for it := src.MapRange(); it.Next(); {
+			tmp := reflect.New(src.Type().Elem()).Elem()
+			c.clone(tmp, it.Value())
+			dst.SetMapIndex(it.Key(), tmp)
+		}
+	case reflect.Slice:
+		if src.IsNil() {
+			dst.SetZero()
+
+			return
+		}
+
+		dst.Set(reflect.MakeSlice(src.Type(), src.Len(), src.Cap()))
+
+		for i := range src.Len() {
+			c.clone(dst.Index(i), src.Index(i))
+		}
+	case reflect.Array:
+		for i := range src.Len() {
+			c.clone(dst.Index(i), src.Index(i))
+		}
+	case reflect.Interface:
+		if src.IsNil() {
+			dst.SetZero()
+
+			return
+		}
+
+		tmp := reflect.New(src.Elem().Type()).Elem()
+		c.clone(tmp, src.Elem())
+		dst.Set(tmp)
+	case reflect.Struct:
+		dst.Set(src) // copies unexported fields
+
+		for i := range src.NumField() {
+			if src.Type().Field(i).IsExported() {
+				c.clone(dst.Field(i), src.Field(i))
+			}
+		}
+	default:
+		dst.Set(src)
+	}
+}
+
+// reuse sets dst to nil or to the already cloned value and returns true, if src is nil or was visited.
+func (c *cloner) reuse(dst, src reflect.Value) bool {
+	if src.IsNil() {
+		dst.SetZero()
+
+		return true
+	}
+
+	if cloned, ok := c.visited[visitKey{src.Pointer(), src.Type()}]; ok {
+		dst.Set(cloned)
+
+		return true
+	}
+
+	return false
+}
+
+// hasClone reports whether t has the `Clone() T` method.
+func hasClone(t reflect.Type) bool {
+	method, ok := t.MethodByName("Clone")
+
+	return ok && t.Kind() != reflect.Interface && method.Type.NumIn() == 1 &&
+		method.Type.NumOut() == 1 && method.Type.Out(0) == t
+}
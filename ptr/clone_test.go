@@ -0,0 +1,227 @@
+package ptr_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sr9000/go-ptr-tools/opt"
+	"github.com/sr9000/go-ptr-tools/ptr"
+	"github.com/sr9000/go-ptr-tools/ref"
+)
+
+type Node struct {
+	Name     string
+	Next     *Node
+	Children []*Node
+}
+
+// Secret redacts its value when cloned.
+type Secret struct {
+	Value string
+}
+
+func (s Secret) Clone() Secret {
+	return Secret{Value: "***"}
+}
+
+// Counter copies itself when cloned, its Clone does not accept nil receivers.
+type Counter struct {
+	Hits int
+}
+
+func (c *Counter) Clone() *Counter {
+	return &Counter{Hits: c.Hits}
+}
+
+type DTO struct {
+	ID      *int
+	Timeout opt.Opt[*time.Duration]
+	Owner   ref.Ref[User]
+	Tags    []string
+	Labels  map[string]*string
+	Payload any
+	Matrix  [2][]int
+}
+
+func newDTO() DTO {
+	return DTO{
+		ID:      ptr.Of(1),
+		Timeout: opt.Of(ptr.Of(time.Second)),
+		Owner:   ref.Of(User{Name: "bob", Email: ptr.Of("bob@example.com")}),
+		Tags:    []string{"a", "b"},
+		Labels:  map[string]*string{"team": ptr.Of("core")},
+		Payload: map[string]any{"k": []any{1.0, "v"}},
+		Matrix:  [2][]int{{1}, {2, 3}},
+	}
+}
+
+func TestClone(t *testing.T) {
+	t.Parallel()
+
+	orig := newDTO()
+	clone := ptr.Clone(orig)
+
+	require.Equal(t, newDTO(), orig, "original is untouched")
+	require.True(t, ptr.DeepEqual(orig, clone))
+
+	require.NotSame(t, orig.ID, clone.ID)
+	require.NotSame(t, orig.Timeout.Ptr(), clone.Timeout.Ptr())
+	require.NotSame(t, orig.Owner.Ptr(), clone.Owner.Ptr())
+	require.NotSame(t, orig.Owner.Val().Email, clone.Owner.Val().Email)
+	require.NotSame(t, orig.Labels["team"], clone.Labels["team"])
+
+	timeout, ok := clone.Timeout.Get()
+	require.True(t, ok)
+
+	// mutate clone and ensure nothing leaks back
+	*clone.ID = 2
+	*timeout = time.Minute
+	*clone.Owner.Val().Email = "alice@example.com"
+	clone.Tags[0] = "z"
+	*clone.Labels["team"] = "infra"
+	clone.Payload.(map[string]any)["k"].([]any)[0] = 2.0 //nolint:forcetypeassert // known payload
+	clone.Matrix[1][0] = 42
+
+	require.True(t, ptr.DeepEqual(newDTO(), orig))
+	require.False(t, ptr.DeepEqual(orig, clone))
+}
+
+func TestCloneMethod(t *testing.T) {
+	t.Parallel()
+
+	clone := ptr.Clone(map[string][]Secret{"db": {{Value: "password"}}})
+	require.Equal(t, map[string][]Secret{"db": {{Value: "***"}}}, clone)
+}
+
+func TestCloneMethodNil(t *testing.T) {
+	t.Parallel()
+
+	type Holder struct {
+		Counter *Counter
+		Set     *Counter
+	}
+
+	orig := Holder{Set: &Counter{Hits: 1}}
+	clone := ptr.Clone(orig)
+	require.Equal(t, orig, clone)
+	require.NotSame(t, orig.Set, clone.Set)
+	require.Nil(t, clone.Counter)
+}
+
+func TestCloneEmbedded(t *testing.T) {
+	t.Parallel()
+
+	type Limit struct {
+		opt.Opt[int]
+
+		Unit string
+	}
+
+	type Holder struct {
+		Limit Limit
+	}
+
+	orig := Holder{Limit: Limit{Opt: opt.Of(5), Unit: "rps"}}
+	require.Equal(t, orig, ptr.Clone(orig))
+	require.True(t, ptr.DeepEqual(orig, ptr.Clone(orig)))
+}
+
+func TestCloneNil(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, DTO{}, ptr.Clone(DTO{}))
+	require.Nil(t, ptr.Clone[*int](nil))
+	require.Nil(t, ptr.Clone[map[string]int](nil))
+	require.Nil(t, ptr.Clone[[]int](nil))
+}
+
+func TestCloneCycle(t *testing.T) {
+	t.Parallel()
+
+	root := &Node{Name: "root"}
+	child := &Node{Name: "child", Next: root}
+	root.Next = child
+	root.Children = []*Node{child, root}
+
+	clone := ptr.Clone(root)
+	require.NotSame(t, root, clone)
+	require.Same(t, clone, clone.Next.Next)
+	require.Same(t, clone.Next, clone.Children[0])
+	require.Same(t, clone, clone.Children[1])
+	require.True(t, ptr.DeepEqual(root, clone))
+}
+
+func BenchmarkClone(b *testing.B) {
+	orig := newDTO()
+
+	b.Run("reflect", func(b *testing.B) {
+		for range b.N {
+			_ = ptr.Clone(orig)
+		}
+	})
+
+	b.Run("hand-written", func(b *testing.B) {
+		for range b.N {
+			_ = cloneDTO(orig)
+		}
+	})
+}
+
+func BenchmarkDeepEqual(b *testing.B) {
+	x, y := newDTO(), newDTO()
+
+	b.Run("reflect", func(b *testing.B) {
+		for range b.N {
+			_ = ptr.DeepEqual(x, y)
+		}
+	})
+
+	b.Run("hand-written", func(b *testing.B) {
+		for range b.N {
+			_ = equalDTO(x, y)
+		}
+	})
+}
+
+func cloneDTO(v DTO) DTO {
+	owner := v.Owner.Val()
+	owner.Email = ptr.Apply(owner.Email, func(s string) string { return s })
+
+	labels := make(map[string]*string, len(v.Labels))
+	for k, label := range v.Labels {
+		labels[k] = ptr.Apply(label, func(s string) string { return s })
+	}
+
+	payload := v.Payload.(map[string]any) //nolint:forcetypeassert // known payload
+
+	return DTO{
+		ID:      ptr.Apply(v.ID, func(i int) int { return i }),
+		Timeout: opt.Apply(v.Timeout, func(d *time.Duration) *time.Duration { return ptr.Of(*d) }),
+		Owner:   ref.Of(owner),
+		Tags:    append([]string(nil), v.Tags...),
+		Labels:  labels,
+		Payload: map[string]any{"k": append([]any(nil), payload["k"].([]any)...)},
+		Matrix:  [2][]int{append([]int(nil), v.Matrix[0]...), append([]int(nil), v.Matrix[1]...)},
+	}
+}
+
+func equalDTO(x, y DTO) bool {
+	if *x.ID != *y.ID || len(x.Tags) != len(y.Tags) {
+		return false
+	}
+
+	for i := range x.Tags {
+		if x.Tags[i] != y.Tags[i] {
+			return false
+		}
+	}
+
+	xOwner, yOwner := x.Owner.Val(), y.Owner.Val()
+	xTimeout, xOk := x.Timeout.Get()
+	yTimeout, yOk := y.Timeout.Get()
+
+	return xOwner.Name == yOwner.Name && *xOwner.Email == *yOwner.Email &&
+		*x.Labels["team"] == *y.Labels["team"] && xOk && yOk && *xTimeout == *yTimeout
+}
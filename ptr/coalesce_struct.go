@@ -0,0 +1,78 @@
+package ptr
+
+import (
+	"reflect"
+
+	"github.com/sr9000/go-ptr-tools/internal/fieldpath"
+)
+
+// CoalesceStruct merges struct layers field by field, the first layer has the highest priority.
+//   - For pointer, slice, map, interface, func, chan and opt.Opt fields it takes the first present value.
+//   - For nested struct fields it recurses into them.
+//   - The rest (plain value) fields are taken from the first not nil layer, use pointers to make them optional.
+//
+// Nil layers are skipped. If all layers are nil, it returns the zero value.
+// Pointers are copied as is (just like Coalesce does), pointees are not cloned.
+func CoalesceStruct[S any](layers ...*S) S {
+	res, _ := CoalesceStructOrigin(layers...)
+
+	return res
+}
+
+// CoalesceStructOrigin works as CoalesceStruct and additionally reports the index of the layer
+// each field came from, keyed by the dot-separated field path (e.g. "Proxy.Host").
+// Optional fields missing in every layer are not reported.
+func CoalesceStructOrigin[S any](layers ...*S) (res S, origin map[string]int) {
+	origin = make(map[string]int)
+
+	values := make([]reflect.Value, 0, len(layers))
+	indexes := make([]int, 0, len(layers))
+
+	for i, layer := range layers {
+		if layer != nil {
+			values = append(values, reflect.ValueOf(layer).Elem())
+			indexes = append(indexes, i)
+		}
+	}
+
+	if len(values) == 0 {
+		return
+	}
+
+	res = *layers[indexes[0]] // copies plain and unexported fields from the top priority layer
+	coalesceValue(reflect.ValueOf(&res).Elem(), values, indexes, "", origin)
+
+	return res, origin
+}
+
+func coalesceValue(dst reflect.Value, layers []reflect.Value, indexes []int, path string, origin map[string]int) {
+	switch {
+	case isOptional(dst.Type()):
+		for i, layer := range layers {
+			if isPresent(layer) {
+				dst.Set(layer)
+				origin[path] = indexes[i]
+
+				return
+			}
+		}
+
+		dst.SetZero()
+	case isNested(dst.Type()):
+		for i := range dst.NumField() {
+			field := dst.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+
+			fields := make([]reflect.Value, len(layers))
+			for j, layer := range layers {
+				fields[j] = layer.Field(i)
+			}
+
+			coalesceValue(dst.Field(i), fields, indexes, fieldpath.JoinPath(path, field.Name), origin)
+		}
+	default:
+		origin[path] = indexes[0]
+	}
+}
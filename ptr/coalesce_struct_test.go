@@ -0,0 +1,109 @@
+package ptr_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sr9000/go-ptr-tools/opt"
+	"github.com/sr9000/go-ptr-tools/ptr"
+)
+
+type Proxy struct {
+	Host *string
+	Port *int
+}
+
+type Config struct {
+	Name    string
+	Timeout opt.Opt[time.Duration]
+	Retries *int
+	Tags    []string
+	Proxy   Proxy
+	Backup  *Proxy
+}
+
+func TestCoalesceStruct(t *testing.T) {
+	t.Parallel()
+
+	env := &Config{
+		Name:  "env",
+		Proxy: Proxy{Host: ptr.Of("env.proxy")},
+	}
+	yaml := &Config{
+		Name:    "yaml",
+		Timeout: opt.Of(5 * time.Second),
+		Tags:    []string{"yaml"},
+		Proxy:   Proxy{Host: ptr.Of("yaml.proxy"), Port: ptr.Of(3128)},
+	}
+	defaults := &Config{
+		Name:    "defaults",
+		Timeout: opt.Of(30 * time.Second),
+		Retries: ptr.Of(3),
+		Proxy:   Proxy{Port: ptr.Of(8080)},
+		Backup:  &Proxy{Host: ptr.Of("backup.proxy")},
+	}
+
+	tests := []struct {
+		name     string
+		layers   []*Config
+		expected Config
+		origin   map[string]int
+	}{
+		{
+			name:   "three layers",
+			layers: []*Config{env, yaml, defaults},
+			expected: Config{
+				Name:    "env",
+				Timeout: opt.Of(5 * time.Second),
+				Retries: defaults.Retries,
+				Tags:    yaml.Tags,
+				Proxy:   Proxy{Host: env.Proxy.Host, Port: yaml.Proxy.Port},
+				Backup:  defaults.Backup,
+			},
+			origin: map[string]int{
+				"Name": 0, "Timeout": 1, "Retries": 2, "Tags": 1,
+				"Proxy.Host": 0, "Proxy.Port": 1, "Backup": 2,
+			},
+		},
+		{
+			name:   "nil layers skipped",
+			layers: []*Config{nil, env, nil},
+			expected: Config{
+				Name:  "env",
+				Proxy: Proxy{Host: env.Proxy.Host},
+			},
+			origin: map[string]int{"Name": 1, "Proxy.Host": 1},
+		},
+		{
+			name:     "no layers",
+			layers:   []*Config{},
+			expected: Config{},
+			origin:   map[string]int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, origin := ptr.CoalesceStructOrigin(tt.layers...)
+			require.Equal(t, tt.expected, res)
+			require.Equal(t, tt.origin, origin)
+			require.Equal(t, tt.expected, ptr.CoalesceStruct(tt.layers...))
+		})
+	}
+}
+
+func TestCoalesceStructSharesPointers(t *testing.T) {
+	t.Parallel()
+
+	top := &Proxy{}
+	bottom := &Proxy{Host: ptr.Of("host"), Port: ptr.Of(80)}
+
+	res := ptr.CoalesceStruct(top, bottom)
+	require.Same(t, bottom.Host, res.Host)
+	require.Same(t, bottom.Port, res.Port)
+	require.Nil(t, top.Host, "layers must stay untouched")
+}
@@ -0,0 +1,119 @@
+package ptr
+
+import (
+	"reflect"
+
+	"github.com/sr9000/go-ptr-tools/opt"
+)
+
+// DeepEqual reports whether a and b are deeply equal. Unlike reflect.DeepEqual it compares meaning, not memory:
+//   - pointers (and ref.Ref values) are equal if pointees are deeply equal, two nil pointers are equal,
+//   - missing opt.Opt values are equal regardless of the value stored inside,
+//   - nil and empty slices (as well as nil and empty maps) are equal,
+//   - types defining the `Equal(T) bool` method (e.g. time.Time) are compared with it.
+func DeepEqual[T any](a, b T) bool {
+	e := equaler{visited: make(map[[2]visitKey]bool)}
+
+	return e.equal(reflect.ValueOf(&a).Elem(), reflect.ValueOf(&b).Elem())
+}
+
+type equaler struct {
+	visited map[[2]visitKey]bool
+}
+
+// equal compares values of the same type.
+func (e *equaler) equal(a, b reflect.Value) bool {
+	switch {
+	case hasEqual(a.Type()) && a.CanInterface():
+		return a.MethodByName("Equal").Call([]reflect.Value{b})[0].Bool()
+	case opt.IsOptType(a.Type()) && a.CanInterface():
+		aVal, aOk := opt.ReflectGet(a)
+		bVal, bOk := opt.ReflectGet(b)
+
+		return aOk == bOk && (!aOk || e.equal(aVal, bVal))
+	default:
+		return e.equalKind(a, b)
+	}
+}
+
+//nolint:cyclop // flat switch over kinds
+func (e *equaler) equalKind(a, b reflect.Value) bool {
+	switch a.Kind() {
+	case reflect.Pointer:
+		if a.IsNil() || b.IsNil() {
+			return a.IsNil() == b.IsNil()
+		}
+
+		return e.seen(a, b) || e.equal(a.Elem(), b.Elem())
+	case reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			return a.IsNil() == b.IsNil()
+		}
+
+		return a.Elem().Type() == b.Elem().Type() && e.equal(a.Elem(), b.Elem())
+	case reflect.Slice, reflect.Array:
+		if a.Len() != b.Len() {
+			return false
+		}
+
+		if a.Kind() == reflect.Slice && a.Len() > 0 && e.seen(a, b) {
+			return true
+		}
+
+		for i := range a.Len() {
+			if !e.equal(a.Index(i), b.Index(i)) {
+				return false
+			}
+		}
+
+		return true
+	case reflect.Map:
+		if a.Len() != b.Len() {
+			return false
+		}
+
+		if a.Len() > 0 && e.seen(a, b) {
+			return true
+		}
+
+		for it := a.MapRange(); it.Next(); {
+			val := b.MapIndex(it.Key())
+			if !val.IsValid() || !e.equal(it.Value(), val) {
+				return false
+			}
+		}
+
+		return true
+	case reflect.Struct:
+		for i := range a.NumField() {
+			if !e.equal(a.Field(i), b.Field(i)) {
+				return false
+			}
+		}
+
+		return true
+	case reflect.Func:
+		return a.IsNil() && b.IsNil() // just like reflect.DeepEqual
+	case reflect.Chan, reflect.UnsafePointer:
+		return a.Pointer() == b.Pointer()
+	default:
+		return a.Equal(b) // basic kinds
+	}
+}
+
+// seen reports whether the pair of references is the same or was already compared (cycle),
+// and marks it as compared otherwise.
+func (e *equaler) seen(a, b reflect.Value) bool {
+	if a.Pointer() == b.Pointer() {
+		return true
+	}
+
+	key := [2]visitKey{{a.Pointer(), a.Type()}, {b.Pointer(), b.Type()}}
+	if e.visited[key] {
+		return true
+	}
+
+	e.visited[key] = true
+
+	return false
+}
@@ -0,0 +1,64 @@
+package ptr_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sr9000/go-ptr-tools/opt"
+	"github.com/sr9000/go-ptr-tools/ptr"
+	"github.com/sr9000/go-ptr-tools/ref"
+)
+
+func TestDeepEqual(t *testing.T) {
+	t.Parallel()
+
+	at := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		a, b     any
+		expected bool
+	}{
+		{"different pointers same pointee", ptr.Of(1), ptr.Of(1), true},
+		{"different pointee", ptr.Of(1), ptr.Of(2), false},
+		{"two nil pointers", (*int)(nil), (*int)(nil), true},
+		{"nil and not nil pointer", (*int)(nil), ptr.Of(0), false},
+		{"refs", ref.Of("x"), ref.Of("x"), true},
+		{"missing opts", opt.FromOk(1, false), opt.Opt[int]{}, true},
+		{"present and missing opt", opt.Of(0), opt.Opt[int]{}, false},
+		{"nil and empty slice", []int(nil), []int{}, true},
+		{"nil and empty map", map[string]int(nil), map[string]int{}, true},
+		{"maps", map[string]*int{"a": ptr.Of(1)}, map[string]*int{"a": ptr.Of(1)}, true},
+		{"maps missing key", map[string]int{"a": 0}, map[string]int{"b": 0}, false},
+		{"equal method", at, at.In(time.FixedZone("UTC+1", 60*60)), true},
+		{"interfaces", []any{1, "a"}, []any{1, "a"}, true},
+		{"interfaces different types", []any{1}, []any{int64(1)}, false},
+		{"structs", User{Email: ptr.Of("e")}, User{Email: ptr.Of("e")}, true},
+		{"structs differ", User{Email: ptr.Of("e")}, User{Email: ptr.Of("f")}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.expected, ptr.DeepEqual(tt.a, tt.b))
+			require.Equal(t, tt.expected, ptr.DeepEqual(tt.b, tt.a))
+		})
+	}
+}
+
+func TestDeepEqualCycle(t *testing.T) {
+	t.Parallel()
+
+	a := &Node{Name: "a"}
+	a.Next = a
+	b := &Node{Name: "a"}
+	b.Next = b
+
+	require.True(t, ptr.DeepEqual(a, b))
+
+	b.Name = "b"
+	require.False(t, ptr.DeepEqual(a, b))
+}
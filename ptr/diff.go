@@ -0,0 +1,170 @@
+package ptr
+
+import (
+	"reflect"
+
+	"github.com/sr9000/go-ptr-tools/internal/fieldpath"
+	"github.com/sr9000/go-ptr-tools/opt"
+	"github.com/sr9000/go-ptr-tools/ref"
+)
+
+// Diff compares struct values before and after and returns a JSON Merge Patch (RFC 7396) turning before into after.
+// The patch is keyed by field names (or json tag names), unchanged fields are absent, and:
+//   - changed fields hold pointers to the new values (*T fields hold the new pointer itself),
+//   - fields changed to nil pointers, missing opt.Opt values or removed map keys hold nil (JSON null),
+//   - nested structs (and pointers to them) and string-keyed maps are compared recursively into nested patches,
+//   - slices and the rest values are replaced as a whole.
+//
+// Values are compared with their `Equal(T) bool` method if present (e.g. time.Time), or with reflect.DeepEqual.
+// The patch can be applied back with ApplyPatch.
+func Diff[S any](before, after S) map[string]any {
+	patch, _ := diffValue(reflect.ValueOf(&before).Elem(), reflect.ValueOf(&after).Elem())
+	if m, ok := patch.(map[string]any); ok {
+		return m
+	}
+
+	return map[string]any{}
+}
+
+// DiffTo works as Diff but returns the patch as a struct P, e.g. with *T or opt.Opt[T] fields.
+// Patch fields are matched by name or by json tag name (see ApplyPatch).
+// Changes to nil are indistinguishable from unchanged fields in P, use Diff to keep them.
+func DiffTo[P, S any](before, after S) (patch P, err error) {
+	_, err = ApplyPatch(&patch, Diff(before, after))
+
+	return
+}
+
+// diffValue returns a patch for the value and true if values differ.
+func diffValue(before, after reflect.Value) (any, bool) {
+	switch {
+	case hasEqual(before.Type()):
+		return leaf(before, after)
+	case opt.IsOptType(before.Type()):
+		beforeVal, beforeOk := opt.ReflectGet(before)
+		afterVal, afterOk := opt.ReflectGet(after)
+
+		switch {
+		case !beforeOk && !afterOk:
+			return nil, false
+		case !afterOk:
+			return nil, true
+		case !beforeOk:
+			return pointerTo(afterVal), true
+		default:
+			return leaf(beforeVal, afterVal)
+		}
+	case before.Kind() == reflect.Pointer:
+		switch {
+		case before.IsNil() && after.IsNil():
+			return nil, false
+		case after.IsNil():
+			return nil, true
+		case before.IsNil():
+			return after.Interface(), true
+		case isNested(before.Type().Elem()) && !hasEqual(before.Type().Elem()):
+			return diffStruct(before.Elem(), after.Elem())
+		default:
+			if equal(before.Elem(), after.Elem()) {
+				return nil, false
+			}
+
+			return after.Interface(), true
+		}
+	case isNested(before.Type()):
+		return diffStruct(before, after)
+	case before.Kind() == reflect.Map && before.Type().Key().Kind() == reflect.String:
+		return diffMap(before, after)
+	default:
+		return leaf(before, after)
+	}
+}
+
+func diffStruct(before, after reflect.Value) (any, bool) {
+	patch := map[string]any{}
+
+	for i := range before.NumField() {
+		field := before.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		if val, changed := diffValue(before.Field(i), after.Field(i)); changed {
+			patch[fieldpath.FieldKey(field)] = val
+		}
+	}
+
+	return patch, len(patch) > 0
+}
+
+func diffMap(before, after reflect.Value) (any, bool) {
+	switch {
+	case before.IsNil() && after.IsNil():
+		return nil, false
+	case after.IsNil():
+		return nil, true
+	}
+
+	patch := map[string]any{}
+
+	for it := before.MapRange(); it.Next(); {
+		if !after.MapIndex(it.Key()).IsValid() {
+			patch[it.Key().String()] = nil
+		}
+	}
+
+	for it := after.MapRange(); it.Next(); {
+		beforeVal := before.MapIndex(it.Key())
+		if !beforeVal.IsValid() {
+			patch[it.Key().String()] = pointerTo(it.Value())
+
+			continue
+		}
+
+		tmp := reflect.New(before.Type().Elem()).Elem()
+		tmp.Set(beforeVal)
+
+		if val, changed := diffValue(tmp, it.Value()); changed {
+			patch[it.Key().String()] = val
+		}
+	}
+
+	return patch, len(patch) > 0 || before.IsNil()
+}
+
+// leaf returns a pointer to the new value if values are not equal.
+func leaf(before, after reflect.Value) (any, bool) {
+	if equal(before, after) {
+		return nil, false
+	}
+
+	return pointerTo(after), true
+}
+
+func pointerTo(v reflect.Value) any {
+	p := reflect.New(v.Type())
+	p.Elem().Set(v)
+
+	return p.Interface()
+}
+
+// hasEqual reports whether t has the `Equal(T) bool` method.
+// ref.Ref is not considered: Refs are compared by their values, not by their addresses.
+func hasEqual(t reflect.Type) bool {
+	if ref.IsRefType(t) {
+		return false
+	}
+
+	method, ok := t.MethodByName("Equal")
+
+	return ok && method.Type.NumIn() == 2 && method.Type.In(1) == t &&
+		method.Type.NumOut() == 1 && method.Type.Out(0).Kind() == reflect.Bool
+}
+
+func equal(a, b reflect.Value) bool {
+	if hasEqual(a.Type()) {
+		return a.MethodByName("Equal").Call([]reflect.Value{b})[0].Bool()
+	}
+
+	return reflect.DeepEqual(a.Interface(), b.Interface())
+}
@@ -0,0 +1,141 @@
+package ptr_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sr9000/go-ptr-tools/opt"
+	"github.com/sr9000/go-ptr-tools/ptr"
+)
+
+type Event struct {
+	Title string
+	At    time.Time
+}
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	base := User{
+		Name:    "bob",
+		Age:     42,
+		Email:   ptr.Of("bob@example.com"),
+		Tags:    []string{"a", "b"},
+		Labels:  map[string]string{"team": "core", "role": "dev"},
+		Address: Address{City: "Oslo"},
+		Billing: &Address{City: "Rome"},
+	}
+
+	tests := []struct {
+		name     string
+		update   func(u *User)
+		expected map[string]any
+	}{
+		{
+			name:     "no changes",
+			update:   func(*User) {},
+			expected: map[string]any{},
+		},
+		{
+			name: "scalars",
+			update: func(u *User) {
+				u.Name = "alice"
+				u.Timeout = opt.Of(time.Second)
+			},
+			expected: map[string]any{"name": ptr.Of("alice"), "Timeout": ptr.Of(time.Second)},
+		},
+		{
+			name: "nil and missing",
+			update: func(u *User) {
+				u.Email = nil
+				u.Billing = nil
+			},
+			expected: map[string]any{"email": nil, "billing": nil},
+		},
+		{
+			name: "nested",
+			update: func(u *User) {
+				u.Address.Street = ptr.Of("Main st.")
+				u.Billing = &Address{City: "Milan"}
+			},
+			expected: map[string]any{
+				"address": map[string]any{"Street": ptr.Of("Main st.")},
+				"billing": map[string]any{"city": ptr.Of("Milan")},
+			},
+		},
+		{
+			name: "slice replaced whole",
+			update: func(u *User) {
+				u.Tags = []string{"a"}
+			},
+			expected: map[string]any{"tags": ptr.Of([]string{"a"})},
+		},
+		{
+			name: "map entries",
+			update: func(u *User) {
+				u.Labels = map[string]string{"team": "infra", "level": "senior"}
+			},
+			expected: map[string]any{"labels": map[string]any{
+				"team": ptr.Of("infra"), "level": ptr.Of("senior"), "role": nil,
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			after := base
+			after.Labels = map[string]string{"team": "core", "role": "dev"}
+			after.Billing = &Address{City: "Rome"}
+			tt.update(&after)
+
+			patch := ptr.Diff(base, after)
+			require.Equal(t, tt.expected, patch)
+
+			// apply the patch back onto a copy of the base value
+			restored := base
+			restored.Labels = map[string]string{"team": "core", "role": "dev"}
+			restored.Billing = &Address{City: "Rome"}
+
+			_, err := ptr.ApplyPatch(&restored, patch)
+			require.NoError(t, err)
+			require.Equal(t, after, restored)
+		})
+	}
+}
+
+func TestDiffEqualMethod(t *testing.T) {
+	t.Parallel()
+
+	at := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	before := Event{Title: "launch", At: at}
+	after := Event{Title: "launch", At: at.In(time.FixedZone("UTC+3", 3*60*60))}
+
+	require.Empty(t, ptr.Diff(before, after), "same instant in other location is equal")
+
+	after.At = at.Add(time.Hour)
+	require.Equal(t, map[string]any{"At": ptr.Of(at.Add(time.Hour))}, ptr.Diff(before, after))
+}
+
+func TestDiffTo(t *testing.T) {
+	t.Parallel()
+
+	before := User{Name: "bob", Age: 42, Address: Address{City: "Oslo"}}
+	after := User{Name: "bob", Age: 0, Email: ptr.Of("bob@example.com"), Address: Address{City: "Paris"}}
+
+	patch, err := ptr.DiffTo[UserPatch](before, after)
+	require.NoError(t, err)
+	require.Equal(t, UserPatch{
+		Age:     opt.Of(0),
+		Email:   ptr.Of("bob@example.com"),
+		Address: &AddressPatch{City: ptr.Of("Paris")},
+	}, patch)
+
+	raw, err := json.Marshal(ptr.Diff(before, after))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"age":0,"email":"bob@example.com","address":{"city":"Paris"}}`, string(raw))
+}
@@ -0,0 +1,16 @@
+package ptr
+
+import (
+	"errors"
+
+	"github.com/sr9000/go-ptr-tools/internal/fieldpath"
+)
+
+var (
+	ErrTypeMismatch = fieldpath.ErrTypeMismatch
+	ErrPathSyntax   = fieldpath.ErrSyntax
+	ErrPathNotFound = fieldpath.ErrNotFound
+	ErrInvalidRoot  = fieldpath.ErrInvalidRoot
+	ErrRequired     = errors.New("required field is missing")
+	ErrInvalidTag   = errors.New("invalid struct tag")
+)
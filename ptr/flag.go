@@ -0,0 +1,53 @@
+package ptr
+
+import (
+	"flag"
+	"fmt"
+)
+
+type flagValue[T any] struct {
+	ptr   **T
+	parse func(string) (T, error)
+}
+
+// FlagVar defines an optional flag with specified name and usage string.
+// The target pointer is left untouched (nil) until the flag is seen on the command line,
+// so `--timeout=0` and omitted `--timeout` are distinguishable.
+// The argument is converted by the parse function, see package parse for built-in parsers.
+// Use opt.Visited to list optional flags that were set.
+func FlagVar[T any](fs *flag.FlagSet, p **T, name, usage string, parse func(string) (T, error)) {
+	fs.Var(&flagValue[T]{ptr: p, parse: parse}, name, usage)
+}
+
+// Set parses the argument and stores a pointer to the result into the target.
+func (f *flagValue[T]) Set(s string) error {
+	v, err := f.parse(s)
+	if err != nil {
+		return err
+	}
+
+	*f.ptr = &v
+
+	return nil
+}
+
+// String returns the formatted value, or an empty string if the pointer is nil.
+func (f *flagValue[T]) String() string {
+	if f.ptr == nil || *f.ptr == nil {
+		return "" // zero flagValue is used by flag.PrintDefaults
+	}
+
+	return fmt.Sprint(**f.ptr)
+}
+
+// IsBoolFlag allows to use boolean optional flags without an argument, e.g. `--verbose`.
+func (f *flagValue[T]) IsBoolFlag() bool {
+	_, ok := any(*new(T)).(bool)
+
+	return ok
+}
+
+// IsOptionalFlag marks the value as an optional flag for opt.Visited.
+func (f *flagValue[T]) IsOptionalFlag() bool {
+	return true
+}
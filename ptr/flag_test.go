@@ -0,0 +1,83 @@
+package ptr_test
+
+import (
+	"flag"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sr9000/go-ptr-tools/parse"
+	"github.com/sr9000/go-ptr-tools/ptr"
+	"github.com/sr9000/go-ptr-tools/ref"
+)
+
+func newFlagSet() *flag.FlagSet {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+
+	return fs
+}
+
+func TestFlagVar(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		args     []string
+		expected *time.Duration
+	}{
+		{"omitted", []string{}, nil},
+		{"zero", []string{"--timeout=0"}, ptr.Of(time.Duration(0))},
+		{"value", []string{"--timeout", "5s"}, ptr.Of(5 * time.Second)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var timeout *time.Duration
+
+			fs := newFlagSet()
+			ptr.FlagVar(fs, &timeout, "timeout", "request timeout", parse.Duration)
+
+			require.NoError(t, fs.Parse(tt.args))
+			require.Equal(t, tt.expected, timeout)
+		})
+	}
+}
+
+func TestFlagVarErr(t *testing.T) {
+	t.Parallel()
+
+	var port *int
+
+	fs := newFlagSet()
+	ptr.FlagVar(fs, &port, "port", "listen port", parse.Int)
+
+	require.Error(t, fs.Parse([]string{"--port=http"}))
+	require.Nil(t, port)
+}
+
+func TestFlagVarLayering(t *testing.T) {
+	t.Parallel()
+
+	var (
+		verbose *bool
+		retries *int
+	)
+
+	fs := newFlagSet()
+	ptr.FlagVar(fs, &verbose, "verbose", "verbose output", parse.Bool)
+	ptr.FlagVar(fs, &retries, "retries", "retries count", parse.Int)
+
+	require.NoError(t, fs.Parse([]string{"--verbose"}))
+	require.NotPanics(t, fs.PrintDefaults)
+	require.Equal(t, "true", fs.Lookup("verbose").Value.String())
+	require.Empty(t, fs.Lookup("retries").Value.String())
+
+	envRetries := ptr.Of(5)
+	require.True(t, ptr.Else(ref.Of(false), verbose).Val())
+	require.Equal(t, 5, ptr.Else(ref.Of(3), retries, envRetries).Val())
+}
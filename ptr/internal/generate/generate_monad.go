@@ -18,16 +18,21 @@ import (
 const (
 	monadGeneratorPath = "internal/generate/generate_monad.go"
 	filename           = "monad.go"
+	chainFilename      = "chain.go"
 
 	ownerWritePermission = 0o644
 
 	argumentsLimit = 9
 	resultsLimit   = 5
+	stepsLimit     = 9
 )
 
 var (
 	//go:embed tmpl/monad.gotmpl
 	monadRaw string
+
+	//go:embed tmpl/chain.gotmpl
+	chainRaw string
 )
 
 type Variant struct {
@@ -72,9 +77,32 @@ func main() {
 		panic(err)
 	}
 
+	generateChain(funcMap, pkg)
+
 	slog.Info("done")
 }
 
+func generateChain(funcMap template.FuncMap, pkg string) {
+	chainTmpl := template.Must(template.New("chain").Funcs(funcMap).Parse(chainRaw))
+
+	var buf bytes.Buffer
+
+	buf.WriteString("// Code generated by generate_monad.go; DO NOT EDIT.\n")
+	buf.WriteString(fmt.Sprintf("package %s\n", pkg))
+
+	for n := 1; n <= stepsLimit; n++ {
+		err := chainTmpl.Execute(&buf, Variant{N: n})
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	err := os.WriteFile(chainFilename, buf.Bytes(), fs.FileMode(ownerWritePermission))
+	if err != nil {
+		panic(err)
+	}
+}
+
 func detectPackageName() string {
 	out, err := exec.Command("go", "list", "-f", "{{.Name}}").Output()
 	if err != nil {
@@ -0,0 +1,273 @@
+package ptr
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/sr9000/go-ptr-tools/internal/fieldpath"
+	"github.com/sr9000/go-ptr-tools/opt"
+)
+
+// ApplyPatch applies the patch onto the value pointed by dst following JSON Merge Patch (RFC 7396) rules
+// and returns dot-separated paths of changed fields (e.g. "Proxy.Host") in the order of application.
+//
+// The patch is a struct (or a pointer to it), a map with string keys or any other value:
+//   - Struct patch fields are matched with dst fields by name or by json tag name, unmatched fields are ignored.
+//   - Nil pointer and missing opt.Opt fields of a struct patch are absent, they leave dst fields untouched.
+//   - Present opt.Opt fields of a struct patch holding nil (e.g. opt.Of[*string](nil)) are nulls,
+//     they reset dst fields to zero.
+//   - Nil values of a map patch are nulls, they remove keys from dst maps and reset dst fields to zero.
+//   - Nil patch of an interface type (e.g. decoded JSON null) resets the whole dst to zero.
+//   - Struct or map values are merged recursively, nil dst pointers and maps are allocated on the way.
+//   - The rest values (scalars, slices, etc.) replace dst values as a whole.
+//
+// Numbers are converted between numeric types only if the conversion is lossless and keeps the sign,
+// other mismatching types cause ErrTypeMismatch. Dst may be partially patched on error.
+func ApplyPatch[S, P any](dst *S, patch P) (changed []string, err error) {
+	p := patcher{}
+	root := reflect.ValueOf(&patch).Elem()
+
+	if root.Kind() == reflect.Interface && root.IsNil() {
+		p.reset(reflect.ValueOf(dst).Elem(), "") // JSON null replaces the whole value
+
+		return p.changed, nil
+	}
+
+	if val := unwrapPatch(root); val.IsValid() {
+		err = p.apply(reflect.ValueOf(dst).Elem(), val, "")
+	}
+
+	return p.changed, err
+}
+
+type patcher struct {
+	changed []string
+}
+
+// unwrapPatch dereferences pointers, interfaces and opt.Opt values.
+// It returns an invalid value for nil pointers, nil interfaces, nil maps, nil slices and missing opt.Opt values.
+func unwrapPatch(v reflect.Value) reflect.Value {
+	for {
+		switch {
+		case opt.IsOptType(v.Type()):
+			inner, ok := opt.ReflectGet(v)
+			if !ok {
+				return reflect.Value{}
+			}
+
+			v = inner
+		case fieldpath.IsNilable(v.Kind()) && v.IsNil():
+			return reflect.Value{}
+		case v.Kind() == reflect.Pointer, v.Kind() == reflect.Interface:
+			v = v.Elem()
+		default:
+			return v
+		}
+	}
+}
+
+// isNull reports whether the struct patch field v is a null: a present opt.Opt holding nil.
+func isNull(v reflect.Value) bool {
+	if !opt.IsOptType(v.Type()) {
+		return false
+	}
+
+	inner, ok := opt.ReflectGet(v)
+
+	return ok && !unwrapPatch(inner).IsValid()
+}
+
+func isObject(v reflect.Value) bool {
+	return (v.Kind() == reflect.Struct && !opt.IsOptType(v.Type())) ||
+		(v.Kind() == reflect.Map && v.Type().Key().Kind() == reflect.String)
+}
+
+func (p *patcher) apply(dst, patch reflect.Value, path string) error {
+	if isObject(patch) {
+		return p.merge(dst, patch, path)
+	}
+
+	return p.assign(dst, patch, path)
+}
+
+// merge merges the object patch (struct or map) into dst.
+func (p *patcher) merge(dst, patch reflect.Value, path string) error {
+	switch {
+	case opt.IsOptType(dst.Type()):
+		tmp := reflect.New(opt.ElemType(dst.Type())).Elem()
+		if cur, ok := opt.ReflectGet(dst); ok {
+			tmp.Set(cur)
+		} else {
+			p.changed = append(p.changed, path)
+		}
+
+		err := p.merge(tmp, patch, path)
+		opt.ReflectSet(dst, tmp)
+
+		return err
+	case dst.Kind() == reflect.Pointer:
+		if dst.IsNil() {
+			dst.Set(reflect.New(dst.Type().Elem()))
+			p.changed = append(p.changed, path)
+		}
+
+		return p.merge(dst.Elem(), patch, path)
+	case dst.Kind() == reflect.Interface:
+		tmp := reflect.ValueOf(map[string]any{})
+		if !dst.IsNil() && isObject(dst.Elem()) {
+			tmp = reflect.New(dst.Elem().Type()).Elem()
+			tmp.Set(dst.Elem())
+		} else {
+			p.changed = append(p.changed, path)
+		}
+
+		err := p.merge(tmp, patch, path)
+		dst.Set(tmp)
+
+		return err
+	case dst.Kind() == reflect.Struct:
+		return p.eachEntry(patch, func(key string, val reflect.Value) error {
+			field, ok := fieldpath.FieldByKey(dst.Type(), key)
+			if !ok {
+				return nil // unknown fields are ignored
+			}
+
+			if !val.IsValid() {
+				p.reset(dst.FieldByIndex(field.Index), fieldpath.JoinPath(path, field.Name))
+
+				return nil
+			}
+
+			return p.apply(dst.FieldByIndex(field.Index), val, fieldpath.JoinPath(path, field.Name))
+		})
+	case isObject(dst):
+		if dst.IsNil() {
+			dst.Set(reflect.MakeMap(dst.Type()))
+			p.changed = append(p.changed, path)
+		}
+
+		return p.eachEntry(patch, func(key string, val reflect.Value) error {
+			k := reflect.ValueOf(key).Convert(dst.Type().Key())
+			if !val.IsValid() {
+				if dst.MapIndex(k).IsValid() {
+					dst.SetMapIndex(k, reflect.Value{})
+					p.changed = append(p.changed, fieldpath.JoinPath(path, key))
+				}
+
+				return nil
+			}
+
+			tmp := reflect.New(dst.Type().Elem()).Elem()
+			if cur := dst.MapIndex(k); cur.IsValid() {
+				tmp.Set(cur)
+			}
+
+			err := p.apply(tmp, val, fieldpath.JoinPath(path, key))
+			dst.SetMapIndex(k, tmp)
+
+			return err
+		})
+	default:
+		return fmt.Errorf("%w: cannot merge object into %s at %q", ErrTypeMismatch, dst.Type(), path)
+	}
+}
+
+// eachEntry iterates over entries of the object patch, map entries are sorted by key.
+// Absent (nil) struct fields are skipped,
+// nil map values and null struct fields are passed as invalid values (JSON null).
+func (p *patcher) eachEntry(patch reflect.Value, fn func(key string, val reflect.Value) error) error {
+	if patch.Kind() == reflect.Struct {
+		for i := range patch.NumField() {
+			field := patch.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+
+			val := unwrapPatch(patch.Field(i))
+			if !val.IsValid() && !isNull(patch.Field(i)) {
+				continue // absent
+			}
+
+			err := fn(fieldpath.FieldKey(field), val)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	keys := patch.MapKeys()
+	slices.SortFunc(keys, func(a, b reflect.Value) int { return cmp.Compare(a.String(), b.String()) })
+
+	for _, key := range keys {
+		err := fn(key.String(), unwrapPatch(patch.MapIndex(key)))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reset sets dst to its zero value.
+func (p *patcher) reset(dst reflect.Value, path string) {
+	if !dst.IsZero() {
+		dst.SetZero()
+		p.changed = append(p.changed, path)
+	}
+}
+
+// assign replaces dst with the (non-object) patch value.
+func (p *patcher) assign(dst, patch reflect.Value, path string) error {
+	var val reflect.Value
+
+	switch {
+	case opt.IsOptType(dst.Type()):
+		tmp, err := convert(patch, opt.ElemType(dst.Type()), path)
+		if err != nil {
+			return err
+		}
+
+		val = reflect.New(dst.Type()).Elem()
+		opt.ReflectSet(val, tmp)
+	case dst.Kind() == reflect.Pointer && !patch.Type().AssignableTo(dst.Type()):
+		tmp, err := convert(patch, dst.Type().Elem(), path)
+		if err != nil {
+			return err
+		}
+
+		val = reflect.New(dst.Type().Elem())
+		val.Elem().Set(tmp)
+	default:
+		var err error
+
+		val, err = convert(patch, dst.Type(), path)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !reflect.DeepEqual(dst.Interface(), val.Interface()) {
+		p.changed = append(p.changed, path)
+	}
+
+	dst.Set(val)
+
+	return nil
+}
+
+// convert converts the value to the type, numbers are converted only if they fit, see fieldpath.FitsNumber.
+func convert(val reflect.Value, typ reflect.Type, path string) (reflect.Value, error) {
+	if val.Type().AssignableTo(typ) {
+		return val, nil
+	}
+
+	if fieldpath.FitsNumber(val, typ) {
+		return val.Convert(typ), nil
+	}
+
+	return reflect.Value{}, fmt.Errorf("%w: cannot assign %s to %s at %q", ErrTypeMismatch, val.Type(), typ, path)
+}
@@ -0,0 +1,247 @@
+package ptr_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sr9000/go-ptr-tools/opt"
+	"github.com/sr9000/go-ptr-tools/ptr"
+)
+
+func unmarshalAny(t *testing.T, raw string) any {
+	t.Helper()
+
+	var v any
+	require.NoError(t, json.Unmarshal([]byte(raw), &v))
+
+	return v
+}
+
+// TestApplyPatchRFC7396 uses the examples table from RFC 7396, Appendix A.
+func TestApplyPatchRFC7396(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		original string
+		patch    string
+		result   string
+	}{
+		{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{`{"a":"b"}`, `{"a":null}`, `{}`},
+		{`{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`},
+		{`{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+		{`{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+		{`["a","b"]`, `["c","d"]`, `["c","d"]`},
+		{`{"a":"b"}`, `["c"]`, `["c"]`},
+		{`{"a":"foo"}`, `null`, `null`},
+		{`{"a":"foo"}`, `"bar"`, `"bar"`},
+		{`{"e":null}`, `{"a":1}`, `{"e":null,"a":1}`},
+		{`[1,2]`, `{"a":"b","c":null}`, `{"a":"b"}`},
+		{`{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.original+" + "+tt.patch, func(t *testing.T) {
+			t.Parallel()
+
+			target := unmarshalAny(t, tt.original)
+
+			_, err := ptr.ApplyPatch(&target, unmarshalAny(t, tt.patch))
+			require.NoError(t, err)
+			require.Equal(t, unmarshalAny(t, tt.result), target)
+		})
+	}
+}
+
+type Address struct {
+	City   string `json:"city"`
+	Street *string
+}
+
+type User struct {
+	Name    string  `json:"name"`
+	Age     int     `json:"age"`
+	Email   *string `json:"email"`
+	Timeout opt.Opt[time.Duration]
+	Tags    []string          `json:"tags"`
+	Labels  map[string]string `json:"labels"`
+	Address Address           `json:"address"`
+	Billing *Address          `json:"billing"`
+}
+
+type AddressPatch struct {
+	City *string `json:"city"`
+}
+
+type UserPatch struct {
+	Name    *string      `json:"name"`
+	Age     opt.Opt[int] `json:"age"`
+	Email   *string      `json:"email"`
+	Timeout opt.Opt[time.Duration]
+	Tags    []string           `json:"tags"`
+	Labels  map[string]*string `json:"labels"`
+	Address *AddressPatch      `json:"address"`
+	Billing *AddressPatch      `json:"billing"`
+	Unknown *int               `json:"unknown"`
+}
+
+func TestApplyPatchStruct(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		patch    UserPatch
+		expected User
+		changed  []string
+	}{
+		{
+			name:     "empty patch",
+			patch:    UserPatch{},
+			expected: User{Name: "bob", Age: 42, Labels: map[string]string{"team": "core"}},
+			changed:  nil,
+		},
+		{
+			name: "scalars",
+			patch: UserPatch{
+				Name:    ptr.Of("alice"),
+				Age:     opt.Of(0),
+				Email:   ptr.Of("alice@example.com"),
+				Timeout: opt.Of(time.Second),
+				Tags:    []string{"admin"},
+			},
+			expected: User{
+				Name:    "alice",
+				Age:     0,
+				Email:   ptr.Of("alice@example.com"),
+				Timeout: opt.Of(time.Second),
+				Tags:    []string{"admin"},
+				Labels:  map[string]string{"team": "core"},
+			},
+			changed: []string{"Name", "Age", "Email", "Timeout", "Tags"},
+		},
+		{
+			name:     "same value is not a change",
+			patch:    UserPatch{Name: ptr.Of("bob"), Age: opt.Of(42)},
+			expected: User{Name: "bob", Age: 42, Labels: map[string]string{"team": "core"}},
+			changed:  nil,
+		},
+		{
+			name:  "nested structs",
+			patch: UserPatch{Address: &AddressPatch{City: ptr.Of("Paris")}, Billing: &AddressPatch{City: ptr.Of("Rome")}},
+			expected: User{
+				Name: "bob", Age: 42, Labels: map[string]string{"team": "core"},
+				Address: Address{City: "Paris"},
+				Billing: &Address{City: "Rome"},
+			},
+			changed: []string{"Address.City", "Billing", "Billing.City"},
+		},
+		{
+			name:  "map entries",
+			patch: UserPatch{Labels: map[string]*string{"team": nil, "role": ptr.Of("dev")}},
+			expected: User{
+				Name: "bob", Age: 42, Labels: map[string]string{"role": "dev"},
+			},
+			changed: []string{"Labels.role", "Labels.team"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			user := User{Name: "bob", Age: 42, Labels: map[string]string{"team": "core"}}
+
+			changed, err := ptr.ApplyPatch(&user, tt.patch)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, user)
+			require.Equal(t, tt.changed, changed)
+		})
+	}
+}
+
+func TestApplyPatchNull(t *testing.T) {
+	t.Parallel()
+
+	type NullablePatch struct {
+		Name    opt.Opt[*string]
+		Email   opt.Opt[*string]        `json:"email"`
+		Billing opt.Opt[*AddressPatch]  `json:"billing"`
+		Tags    opt.Opt[[]string]       `json:"tags"`
+		Labels  opt.Opt[map[string]any] `json:"labels"`
+	}
+
+	user := User{Name: "bob", Email: ptr.Of("bob@example.com"), Billing: &Address{City: "Oslo"}, Tags: []string{"a"}}
+
+	changed, err := ptr.ApplyPatch(&user, NullablePatch{
+		Email:   opt.Of[*string](nil),
+		Billing: opt.Of[*AddressPatch](nil),
+		Tags:    opt.Of[[]string](nil),
+		Labels:  opt.Of[map[string]any](nil),
+	})
+	require.NoError(t, err)
+	require.Equal(t, User{Name: "bob"}, user)
+	require.Equal(t, []string{"Email", "Billing", "Tags"}, changed)
+}
+
+func TestApplyPatchMap(t *testing.T) {
+	t.Parallel()
+
+	user := User{Name: "bob", Age: 42, Email: ptr.Of("bob@example.com")}
+	patch := unmarshalAny(t, `{"age": 43, "email": null, "address": {"city": "Oslo"}, "Timeout": 1000}`)
+
+	changed, err := ptr.ApplyPatch(&user, patch)
+	require.NoError(t, err)
+	require.Equal(t, User{Name: "bob", Age: 43, Timeout: opt.Of(time.Microsecond), Address: Address{City: "Oslo"}}, user)
+	require.Equal(t, []string{"Timeout", "Address.City", "Age", "Email"}, changed)
+}
+
+func TestApplyPatchErr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		patch string
+	}{
+		{"lossy number", `{"age": 4.2}`},
+		{"string into int", `{"age": "42"}`},
+		{"object into string", `{"name": {"first": "bob"}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var user User
+
+			_, err := ptr.ApplyPatch(&user, unmarshalAny(t, tt.patch))
+			require.ErrorIs(t, err, ptr.ErrTypeMismatch)
+		})
+	}
+
+	t.Run("negative into unsigned", func(t *testing.T) {
+		t.Parallel()
+
+		var stats struct{ Count uint }
+
+		_, err := ptr.ApplyPatch(&stats, map[string]any{"Count": -1})
+		require.ErrorIs(t, err, ptr.ErrTypeMismatch)
+		require.Zero(t, stats.Count)
+	})
+
+	t.Run("nil patch pointer is absent", func(t *testing.T) {
+		t.Parallel()
+
+		user := User{Name: "bob"}
+
+		changed, err := ptr.ApplyPatch(&user, (*UserPatch)(nil))
+		require.NoError(t, err)
+		require.Empty(t, changed)
+		require.Equal(t, User{Name: "bob"}, user)
+	})
+}
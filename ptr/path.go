@@ -0,0 +1,53 @@
+package ptr
+
+import (
+	"reflect"
+
+	"github.com/sr9000/go-ptr-tools/internal/fieldpath"
+	"github.com/sr9000/go-ptr-tools/opt"
+)
+
+// GetPath returns the value at the path within root, e.g. "User.Profile.Address.City" or "Items[2].Price".
+// Fields are separated with dots and matched by name or by json tag name,
+// slice and array indexes as well as map keys are given in brackets.
+// Pointers, interfaces, opt.Opt and ref.Ref values on the way (and at the end) are dereferenced.
+//   - If some of them is nil or missing (as well as a map key), it returns false and no error.
+//   - If the path is malformed, it returns ErrPathSyntax.
+//   - If the path does not fit the value (unknown field, index out of range), it returns ErrPathNotFound.
+func GetPath(root any, path string) (val any, ok bool, err error) {
+	if root == nil {
+		return nil, false, nil
+	}
+
+	v, ok, err := pathWalker().Get(reflect.ValueOf(root), path)
+	if !ok || err != nil {
+		return nil, false, err
+	}
+
+	return v.Interface(), true, nil
+}
+
+// SetPath stores v at the path within the struct (or other value) pointed by root, see GetPath for the path syntax.
+// Nil pointers, maps and missing opt.Opt values on the way are allocated.
+// The value is converted to the field type: pointers and opt.Opt are allocated for plain values,
+// numbers are converted if there is no precision loss, nil resets the field to zero.
+// Otherwise, it returns ErrTypeMismatch.
+func SetPath(root any, path string, v any) error {
+	return pathWalker().Set(reflect.ValueOf(root), path, reflect.ValueOf(v))
+}
+
+// ClearPath resets the value at the path within the struct (or other value) pointed by root to zero:
+// a nil pointer, a missing opt.Opt or a removed map key. See GetPath for the path syntax.
+// Nil or missing values on the way are left as is, there is nothing to clear.
+func ClearPath(root any, path string) error {
+	return pathWalker().Clear(reflect.ValueOf(root), path)
+}
+
+func pathWalker() fieldpath.Walker {
+	return fieldpath.Walker{Opt: fieldpath.OptHooks{
+		IsOpt:    opt.IsOptType,
+		ElemType: opt.ElemType,
+		Get:      opt.ReflectGet,
+		Set:      opt.ReflectSet,
+	}}
+}
@@ -0,0 +1,175 @@
+package ptr_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sr9000/go-ptr-tools/opt"
+	"github.com/sr9000/go-ptr-tools/ptr"
+	"github.com/sr9000/go-ptr-tools/ref"
+)
+
+type Item struct {
+	Price float64 `json:"price"`
+	Note  opt.Opt[string]
+}
+
+type Order struct {
+	Request *Request
+	Items   []Item
+	Meta    map[string]*Item
+	Counts  map[int]int
+	Extra   any
+	Owner   ref.Ref[Account]
+}
+
+func TestGetPath(t *testing.T) {
+	t.Parallel()
+
+	order := Order{
+		Request: &Request{User: &Account{Profile: &Profile{Address: &Address{City: "Oslo"}}}},
+		Items:   []Item{{Price: 1}, {Price: 2, Note: opt.Of("gift")}},
+		Meta:    map[string]*Item{"first": {Price: 3}, "nil": nil},
+		Counts:  map[int]int{7: 49},
+		Extra:   map[string]any{"k": []any{"v"}},
+		Owner:   ref.Of(Account{}),
+	}
+
+	tests := []struct {
+		name     string
+		path     string
+		expected any
+		ok       bool
+	}{
+		{"nested pointers", "Request.User.Profile.Address.City", "Oslo", true},
+		{"nil on the way", "Request.User.Profile.Address.Street", nil, false},
+		{"index", "Items[1].Price", 2.0, true},
+		{"json tag", "Items[0].price", 1.0, true},
+		{"opt present", "Items[1].Note", "gift", true},
+		{"opt missing", "Items[0].Note", nil, false},
+		{"map key", "Meta[first].Price", 3.0, true},
+		{"map nil value", "Meta[nil].Price", nil, false},
+		{"map missing key", "Meta[second].Price", nil, false},
+		{"map int key", "Counts[7]", 49, true},
+		{"interface", "Extra[k][0]", "v", true},
+		{"ref", "Owner.Profile", nil, false},
+		{"whole struct", "Items[0]", Item{Price: 1}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			val, ok, err := ptr.GetPath(order, tt.path)
+			require.NoError(t, err)
+			require.Equal(t, tt.ok, ok)
+			require.Equal(t, tt.expected, val)
+
+			val, ok, err = ptr.GetPath(&order, tt.path)
+			require.NoError(t, err)
+			require.Equal(t, tt.ok, ok)
+			require.Equal(t, tt.expected, val)
+		})
+	}
+}
+
+func TestGetPathErr(t *testing.T) {
+	t.Parallel()
+
+	order := Order{Items: []Item{{}}, Counts: map[int]int{}}
+
+	tests := []struct {
+		name     string
+		path     string
+		expected error
+	}{
+		{"empty", "", ptr.ErrPathSyntax},
+		{"double dot", "Items..Price", ptr.ErrPathSyntax},
+		{"unclosed bracket", "Items[0.Price", ptr.ErrPathSyntax},
+		{"empty index", "Items[]", ptr.ErrPathSyntax},
+		{"garbage after index", "Items[0]Price", ptr.ErrPathSyntax},
+		{"unknown field", "Itemz[0]", ptr.ErrPathNotFound},
+		{"index out of range", "Items[1].Price", ptr.ErrPathNotFound},
+		{"bad index", "Items[x].Price", ptr.ErrPathNotFound},
+		{"bad map key", "Counts[x]", ptr.ErrPathNotFound},
+		{"field of scalar", "Items[0].Price.Cents", ptr.ErrPathNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, ok, err := ptr.GetPath(order, tt.path)
+			require.ErrorIs(t, err, tt.expected)
+			require.False(t, ok)
+		})
+	}
+}
+
+func TestSetPath(t *testing.T) {
+	t.Parallel()
+
+	var order Order
+
+	require.NoError(t, ptr.SetPath(&order, "Request.User.Profile.Address.City", "Oslo"))
+	require.NoError(t, ptr.SetPath(&order, "Request.User.Profile.Address.Street", "Main st."))
+	require.Equal(t, &Address{City: "Oslo", Street: ptr.Of("Main st.")}, order.Request.User.Profile.Address)
+
+	require.NoError(t, ptr.SetPath(&order, "Meta[first].Note", "gift"))
+	require.Equal(t, map[string]*Item{"first": {Note: opt.Of("gift")}}, order.Meta)
+
+	require.NoError(t, ptr.SetPath(&order, "Counts[7]", 49.0))
+	require.Equal(t, map[int]int{7: 49}, order.Counts)
+
+	require.NoError(t, ptr.SetPath(&order, "Extra[k]", 1))
+	require.Equal(t, map[string]any{"k": 1}, order.Extra)
+
+	order.Items = make([]Item, 2)
+	require.NoError(t, ptr.SetPath(&order, "Items[1].price", 2))
+	require.InDelta(t, 2.0, order.Items[1].Price, 0)
+
+	require.NoError(t, ptr.SetPath(&order, "Request.User.Profile.Address.Street", nil))
+	require.Nil(t, order.Request.User.Profile.Address.Street)
+
+	require.ErrorIs(t, ptr.SetPath(&order, "Items[1].Price", "free"), ptr.ErrTypeMismatch)
+	require.ErrorIs(t, ptr.SetPath(&order, "Counts[7]", 0.5), ptr.ErrTypeMismatch)
+	require.ErrorIs(t, ptr.SetPath(&order, "Items[2].Price", 1), ptr.ErrPathNotFound)
+	require.ErrorIs(t, ptr.SetPath(&order, "Items[", 1), ptr.ErrPathSyntax)
+	require.ErrorIs(t, ptr.SetPath(order, "Counts[7]", 1), ptr.ErrInvalidRoot)
+
+	var stats struct{ Count uint }
+	require.ErrorIs(t, ptr.SetPath(&stats, "Count", -5), ptr.ErrTypeMismatch)
+	require.Zero(t, stats.Count)
+}
+
+func TestClearPath(t *testing.T) {
+	t.Parallel()
+
+	order := Order{
+		Request: &Request{User: &Account{Profile: &Profile{Address: &Address{City: "Oslo"}}}},
+		Items:   []Item{{Price: 2, Note: opt.Of("gift")}},
+		Meta:    map[string]*Item{"first": {Price: 3}},
+		Extra:   map[string]any{"k": map[string]any{"a": 1, "b": 2}},
+	}
+
+	require.NoError(t, ptr.ClearPath(&order, "Request.User.Profile.Address"))
+	require.Nil(t, order.Request.User.Profile.Address)
+
+	require.NoError(t, ptr.ClearPath(&order, "Items[0].Note"))
+	require.True(t, order.Items[0].Note.IsMissing())
+
+	require.NoError(t, ptr.ClearPath(&order, "Meta[first]"))
+	require.Empty(t, order.Meta)
+
+	require.NoError(t, ptr.ClearPath(&order, "Extra[k][a]"))
+	require.Equal(t, map[string]any{"k": map[string]any{"b": 2}}, order.Extra)
+
+	// nothing to clear
+	require.NoError(t, ptr.ClearPath(&order, "Request.User.Profile.Address.City"))
+	require.NoError(t, ptr.ClearPath(&order, "Owner.Profile.Address"))
+	require.Nil(t, order.Request.User.Profile.Address, "absent pointers are not allocated")
+
+	require.ErrorIs(t, ptr.ClearPath(&order, "Items[9]"), ptr.ErrPathNotFound)
+	require.ErrorIs(t, ptr.ClearPath(&order, ".Items"), ptr.ErrPathSyntax)
+}
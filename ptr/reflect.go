@@ -0,0 +1,51 @@
+package ptr
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/sr9000/go-ptr-tools/internal/fieldpath"
+	"github.com/sr9000/go-ptr-tools/opt"
+)
+
+// isOptional reports whether values of the type t carry presence: nilable types and opt.Opt.
+func isOptional(t reflect.Type) bool {
+	return fieldpath.IsNilable(t.Kind()) || opt.IsOptType(t)
+}
+
+// isPresent reports whether the optional value v is present: not nil pointer or present opt.Opt.
+func isPresent(v reflect.Value) bool {
+	if opt.IsOptType(v.Type()) {
+		_, ok := opt.ReflectGet(v)
+
+		return ok
+	}
+
+	return !v.IsNil()
+}
+
+// isNested reports whether t is a struct to walk into: a struct (but not opt.Opt) with exported fields.
+func isNested(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct || opt.IsOptType(t) {
+		return false
+	}
+
+	for i := range t.NumField() {
+		if t.Field(i).IsExported() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasTagOption reports whether the `ptr` tag of the field lists the option, e.g. `ptr:"required,keepnil"`.
+func hasTagOption(field reflect.StructField, option string) bool {
+	for _, name := range strings.Split(field.Tag.Get(requireTag), ",") {
+		if name == option {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,216 @@
+package ptr
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/sr9000/go-ptr-tools/internal/fieldpath"
+	"github.com/sr9000/go-ptr-tools/opt"
+	"github.com/sr9000/go-ptr-tools/ref"
+)
+
+const requireTag = "ptr"
+
+// MissingFieldsError lists the dot-separated paths (e.g. "User.Profile", "Items[2].Price") of missing required fields.
+type MissingFieldsError struct {
+	Paths []string
+}
+
+func (e *MissingFieldsError) Error() string {
+	return "missing required fields: " + strings.Join(e.Paths, ", ")
+}
+
+// Unwrap makes the error match ErrRequired.
+func (e *MissingFieldsError) Unwrap() error {
+	return ErrRequired
+}
+
+// Require checks struct fields tagged as required and reports all missing ones at once with *MissingFieldsError.
+//   - `ptr:"required"` fields must be present: not nil pointer, slice, map or interface, present opt.Opt,
+//     valid ref.Ref, or not zero for the rest types.
+//   - `ptr:"required_if=Kind:admin"` fields are required only if the sibling field Kind (matched by name
+//     or json tag name, dereferenced) formats as "admin". A field with several rules is required if any holds.
+//
+// Present nested structs, pointers, opt.Opt and ref.Ref values, slice and array elements, map values
+// and interfaces are checked recursively. It returns ref.ErrPtrMustBeNotNil for the nil v
+// and ErrInvalidTag for malformed tags and unknown options.
+func Require(v any) error {
+	root := reflect.ValueOf(v)
+	if !root.IsValid() || (root.Kind() == reflect.Pointer && root.IsNil()) {
+		return ref.ErrPtrMustBeNotNil
+	}
+
+	r := requirer{visited: make(map[visitKey]bool)}
+	if err := r.walk(root, ""); err != nil {
+		return err
+	}
+
+	if len(r.missing) > 0 {
+		return &MissingFieldsError{Paths: r.missing}
+	}
+
+	return nil
+}
+
+type requirer struct {
+	visited map[visitKey]bool
+	missing []string
+}
+
+// walk checks required fields of all structs reachable from the value.
+func (r *requirer) walk(v reflect.Value, path string) error {
+	switch {
+	case opt.IsOptType(v.Type()):
+		if val, ok := opt.ReflectGet(v); ok {
+			return r.walk(val, path)
+		}
+	case ref.IsRefType(v.Type()):
+		return r.walk(ref.ReflectPtr(v), path)
+	case v.Kind() == reflect.Pointer:
+		if v.IsNil() {
+			return nil
+		}
+
+		key := visitKey{addr: v.Pointer(), typ: v.Type()}
+		if r.visited[key] {
+			return nil
+		}
+
+		r.visited[key] = true
+
+		return r.walk(v.Elem(), path)
+	case v.Kind() == reflect.Interface:
+		if !v.IsNil() {
+			return r.walk(v.Elem(), path)
+		}
+	case v.Kind() == reflect.Struct:
+		return r.walkStruct(v, path)
+	case v.Kind() == reflect.Slice || v.Kind() == reflect.Array:
+		for i := range v.Len() {
+			if err := r.walk(v.Index(i), path+"["+strconv.Itoa(i)+"]"); err != nil {
+				return err
+			}
+		}
+	case v.Kind() == reflect.Map:
+		keys := v.MapKeys()
+		slices.SortFunc(keys, func(a, b reflect.Value) int { return cmp.Compare(fmt.Sprint(a), fmt.Sprint(b)) })
+
+		for _, key := range keys {
+			if err := r.walk(v.MapIndex(key), path+"["+fmt.Sprint(key)+"]"); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *requirer) walkStruct(v reflect.Value, path string) error {
+	for i := range v.NumField() {
+		field := v.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		fieldPath := fieldpath.JoinPath(path, field.Name)
+
+		required, err := isRequired(v, field)
+		if err != nil {
+			return fmt.Errorf("%w: field %s: %w", ErrInvalidTag, fieldPath, err)
+		}
+
+		if !isSet(v.Field(i)) {
+			if required {
+				r.missing = append(r.missing, fieldPath)
+			}
+
+			continue
+		}
+
+		if err = r.walk(v.Field(i), fieldPath); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isRequired parses the tag of the field and evaluates its conditions against the sibling fields of the struct v,
+// the field is required if any of them holds. Options of other functions (keepzero, keepnil) are skipped.
+func isRequired(v reflect.Value, field reflect.StructField) (bool, error) {
+	tag, ok := field.Tag.Lookup(requireTag)
+	if !ok {
+		return false, nil
+	}
+
+	required := false
+
+	for _, rule := range strings.Split(tag, ",") {
+		name, cond, _ := strings.Cut(rule, "=")
+
+		switch name {
+		case "required":
+			required = true
+		case "required_if":
+			key, want, found := strings.Cut(cond, ":")
+			if !found {
+				return false, fmt.Errorf("expected required_if=Field:value, got %q", rule)
+			}
+
+			sibling, found := fieldpath.FieldByKey(v.Type(), key)
+			if !found {
+				return false, fmt.Errorf("unknown field %q in %q", key, rule)
+			}
+
+			if val, present := unwrap(v.FieldByIndex(sibling.Index)); present && fmt.Sprint(val.Interface()) == want {
+				required = true
+			}
+		case "keepzero", "keepnil", "":
+		default:
+			return false, fmt.Errorf("unknown option %q", rule)
+		}
+	}
+
+	return required, nil
+}
+
+// isSet reports whether the required field value is present.
+func isSet(v reflect.Value) bool {
+	switch {
+	case isOptional(v.Type()):
+		return isPresent(v)
+	case ref.IsRefType(v.Type()):
+		return !ref.ReflectPtr(v).IsNil()
+	default:
+		return !v.IsZero()
+	}
+}
+
+// unwrap dereferences pointers, interfaces, opt.Opt and ref.Ref values, returns false if one is absent.
+func unwrap(v reflect.Value) (reflect.Value, bool) {
+	for {
+		switch {
+		case opt.IsOptType(v.Type()):
+			val, ok := opt.ReflectGet(v)
+			if !ok {
+				return reflect.Value{}, false
+			}
+
+			v = val
+		case ref.IsRefType(v.Type()):
+			v = ref.ReflectPtr(v)
+		case v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface:
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+
+			v = v.Elem()
+		default:
+			return v, true
+		}
+	}
+}
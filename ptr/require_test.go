@@ -0,0 +1,159 @@
+package ptr_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sr9000/go-ptr-tools/opt"
+	"github.com/sr9000/go-ptr-tools/ptr"
+	"github.com/sr9000/go-ptr-tools/ref"
+)
+
+type Member struct {
+	Name  *string `ptr:"required"`
+	Kind  opt.Opt[string]
+	Token *string `ptr:"required_if=Kind:admin"`
+}
+
+type Team struct {
+	ID      int                `ptr:"required"`
+	Lead    ref.Ref[Member]    `ptr:"required"`
+	Deputy  opt.Opt[Member]    `ptr:"required"`
+	Members []Member           `ptr:"required"`
+	ByName  map[string]*Member `json:"by_name"`
+	Parent  *Team
+}
+
+func TestRequire(t *testing.T) {
+	t.Parallel()
+
+	name := ptr.Of("bob")
+	valid := Team{
+		ID:      1,
+		Lead:    ref.Of(Member{Name: name}),
+		Deputy:  opt.Of(Member{Name: name, Kind: opt.Of("admin"), Token: ptr.Of("t")}),
+		Members: []Member{{Name: name}},
+		ByName:  map[string]*Member{"bob": {Name: name}, "nobody": nil},
+	}
+
+	require.NoError(t, ptr.Require(valid))
+	require.NoError(t, ptr.Require(&valid))
+
+	tests := []struct {
+		name     string
+		mutate   func(*Team)
+		expected []string
+	}{
+		{
+			name:     "zero team",
+			mutate:   func(team *Team) { *team = Team{} },
+			expected: []string{"ID", "Lead", "Deputy", "Members"},
+		},
+		{
+			name:     "nested",
+			mutate:   func(team *Team) { team.Lead = ref.Of(Member{}) },
+			expected: []string{"Lead.Name"},
+		},
+		{
+			name:     "required if",
+			mutate:   func(team *Team) { team.Members = []Member{{Name: name}, {Name: name, Kind: opt.Of("admin")}} },
+			expected: []string{"Members[1].Token"},
+		},
+		{
+			name:     "maps and pointers",
+			mutate:   func(team *Team) { team.Parent = &Team{ByName: map[string]*Member{"x": {}, "a": {}}} },
+			expected: []string{"Parent.ID", "Parent.Lead", "Parent.Deputy", "Parent.Members", "Parent.ByName[a].Name", "Parent.ByName[x].Name"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			team := valid
+			tt.mutate(&team)
+
+			err := ptr.Require(&team)
+			require.ErrorIs(t, err, ptr.ErrRequired)
+
+			var missing *ptr.MissingFieldsError
+			require.ErrorAs(t, err, &missing)
+			require.Equal(t, tt.expected, missing.Paths)
+		})
+	}
+}
+
+func TestRequireCycle(t *testing.T) {
+	t.Parallel()
+
+	team := &Team{ID: 1, Lead: ref.Of(Member{Name: ptr.Of("bob")})}
+	team.Parent = team
+
+	var missing *ptr.MissingFieldsError
+	require.ErrorAs(t, ptr.Require(team), &missing)
+	require.Equal(t, []string{"Deputy", "Members"}, missing.Paths)
+}
+
+func TestRequireErr(t *testing.T) {
+	t.Parallel()
+
+	require.ErrorIs(t, ptr.Require(nil), ref.ErrPtrMustBeNotNil)
+	require.ErrorIs(t, ptr.Require((*Team)(nil)), ref.ErrPtrMustBeNotNil)
+
+	badSyntax := struct {
+		Kind  string
+		Token *string `ptr:"required_if=Kind"`
+	}{}
+	require.ErrorIs(t, ptr.Require(badSyntax), ptr.ErrInvalidTag)
+
+	unknownField := struct {
+		Token *string `ptr:"required_if=Role:admin"`
+	}{}
+	err := ptr.Require(unknownField)
+	require.ErrorIs(t, err, ptr.ErrInvalidTag)
+	require.NotErrorIs(t, err, ptr.ErrRequired)
+
+	unknownOption := struct {
+		Token *string `ptr:"requird"`
+	}{}
+	require.ErrorIs(t, ptr.Require(unknownOption), ptr.ErrInvalidTag)
+
+	otherOptions := struct {
+		Token *string `ptr:"keepzero,keepnil"`
+	}{}
+	require.NoError(t, ptr.Require(otherOptions))
+}
+
+func TestRequireAnyRule(t *testing.T) {
+	t.Parallel()
+
+	type Grant struct {
+		Kind  string
+		Token *string `ptr:"required_if=Kind:a,required_if=Kind:b"`
+	}
+
+	tests := []struct {
+		kind     string
+		required bool
+	}{
+		{"a", true},
+		{"b", true},
+		{"c", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.kind, func(t *testing.T) {
+			t.Parallel()
+
+			err := ptr.Require(Grant{Kind: tt.kind})
+			if tt.required {
+				require.ErrorIs(t, err, ptr.ErrRequired)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.NoError(t, ptr.Require(Grant{Kind: tt.kind, Token: ptr.Of("t")}))
+		})
+	}
+}
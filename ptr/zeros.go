@@ -0,0 +1,163 @@
+package ptr
+
+import (
+	"reflect"
+
+	"github.com/sr9000/go-ptr-tools/opt"
+	"github.com/sr9000/go-ptr-tools/ref"
+)
+
+// NilZeros walks the struct (or any value) s points to and replaces pointers to zero values with nil
+// and present opt.Opt zero values with missing ones, just like FromZero does for a single value.
+//   - Nested structs, pointees, opt.Opt and ref.Ref values, slice and array elements and map values
+//     are normalized recursively and bottom-up: a pointer to a struct whose fields all became zero is nil-ed too.
+//   - Fields tagged with `ptr:"keepzero"` are left as is (their contents are still normalized).
+//   - Only struct fields are nil-ed: slice elements, map and interface values are walked into but kept.
+func NilZeros[T any](s *T) {
+	if s == nil {
+		return
+	}
+
+	z := zeroer{visited: make(map[visitKey]bool)}
+	z.nilZeros(reflect.ValueOf(s).Elem(), false)
+}
+
+// FillZeros walks the struct (or any value) s points to and replaces nil pointers with pointers to zero values
+// and missing opt.Opt values with present zero ones, the opposite of NilZeros.
+//   - Nested structs, allocated pointees, opt.Opt and ref.Ref values, slice and array elements and map values
+//     are filled recursively. Recursive types are filled down to the first repetition of the type.
+//   - Fields tagged with `ptr:"keepnil"` are left as is (present ones are still filled).
+//   - Only struct fields are filled: slice elements, map and interface values are walked into but kept.
+func FillZeros[T any](s *T) {
+	if s == nil {
+		return
+	}
+
+	z := zeroer{filling: make(map[reflect.Type]bool)}
+	z.fillZeros(reflect.ValueOf(s).Elem(), false)
+}
+
+type zeroer struct {
+	visited map[visitKey]bool     // pointers already normalized by NilZeros
+	filling map[reflect.Type]bool // struct types on the current FillZeros path
+}
+
+// nilZeros normalizes the settable v, keep disables nil-ing of v itself.
+func (z *zeroer) nilZeros(v reflect.Value, keep bool) {
+	switch {
+	case opt.IsOptType(v.Type()):
+		val, ok := opt.ReflectGet(v)
+		if !ok {
+			return
+		}
+
+		tmp := reflect.New(val.Type()).Elem()
+		tmp.Set(val)
+		z.nilZeros(tmp, false)
+
+		if !keep && tmp.IsZero() {
+			v.SetZero()
+		} else {
+			opt.ReflectSet(v, tmp)
+		}
+	case ref.IsRefType(v.Type()):
+		z.nilZeros(ref.ReflectPtr(v), true)
+	case v.Kind() == reflect.Pointer:
+		if v.IsNil() {
+			return
+		}
+
+		key := visitKey{addr: v.Pointer(), typ: v.Type()}
+		if !z.visited[key] {
+			z.visited[key] = true
+			z.nilZeros(v.Elem(), false)
+		}
+
+		if !keep && v.Elem().IsZero() && v.CanSet() {
+			v.SetZero()
+		}
+	default:
+		z.walk(v, z.nilZeros, "keepzero")
+	}
+}
+
+// fillZeros fills the settable v, keep disables allocation of v itself.
+func (z *zeroer) fillZeros(v reflect.Value, keep bool) {
+	switch {
+	case opt.IsOptType(v.Type()):
+		val, ok := opt.ReflectGet(v)
+		if !ok && (keep || z.isFilling(opt.ElemType(v.Type()))) {
+			return
+		}
+
+		tmp := reflect.New(opt.ElemType(v.Type())).Elem()
+		if ok {
+			tmp.Set(val)
+		}
+
+		z.fillZeros(tmp, false)
+		opt.ReflectSet(v, tmp)
+	case ref.IsRefType(v.Type()):
+		if p := ref.ReflectPtr(v); !p.IsNil() {
+			z.fillZeros(p, true)
+		}
+	case v.Kind() == reflect.Pointer:
+		if v.IsNil() {
+			if keep || z.isFilling(v.Type().Elem()) || !v.CanSet() {
+				return
+			}
+
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+
+		z.fillZeros(v.Elem(), false)
+	case v.Kind() == reflect.Struct:
+		z.filling[v.Type()] = true
+		z.walk(v, z.fillZeros, "keepnil")
+		delete(z.filling, v.Type())
+	default:
+		z.walk(v, z.fillZeros, "keepnil")
+	}
+}
+
+// isFilling reports whether the type t (or the type it points to) is being filled on the current path.
+func (z *zeroer) isFilling(t reflect.Type) bool {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	return z.filling[t]
+}
+
+// walk calls fn for exported struct fields (passing keep for fields tagged with the option),
+// slice and array elements, interface and map values of v.
+func (z *zeroer) walk(v reflect.Value, fn func(reflect.Value, bool), option string) {
+	switch {
+	case v.Kind() == reflect.Struct:
+		for i := range v.NumField() {
+			field := v.Type().Field(i)
+			if field.IsExported() {
+				fn(v.Field(i), hasTagOption(field, option))
+			}
+		}
+	case v.Kind() == reflect.Slice || v.Kind() == reflect.Array:
+		for i := range v.Len() {
+			fn(v.Index(i), true)
+		}
+	case v.Kind() == reflect.Interface && !v.IsNil():
+		tmp := reflect.New(v.Elem().Type()).Elem()
+		tmp.Set(v.Elem())
+		fn(tmp, true)
+
+		if v.CanSet() {
+			v.Set(tmp)
+		}
+	case v.Kind() == reflect.Map:
+		for _, key := range v.MapKeys() {
+			tmp := reflect.New(v.Type().Elem()).Elem()
+			tmp.Set(v.MapIndex(key))
+			fn(tmp, true)
+			v.SetMapIndex(key, tmp)
+		}
+	}
+}
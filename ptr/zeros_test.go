@@ -0,0 +1,96 @@
+package ptr_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sr9000/go-ptr-tools/opt"
+	"github.com/sr9000/go-ptr-tools/ptr"
+	"github.com/sr9000/go-ptr-tools/ref"
+)
+
+type Limits struct {
+	Max   *int
+	Ratio opt.Opt[float64]
+}
+
+type Settings struct {
+	Name     *string
+	Retries  *int `ptr:"keepzero,keepnil"`
+	Verbose  opt.Opt[bool]
+	Limits   *Limits
+	Defaults ref.Ref[Limits]
+	History  []*int
+	ByRegion map[string]Limits
+}
+
+func TestNilZeros(t *testing.T) {
+	t.Parallel()
+
+	settings := Settings{
+		Name:     ptr.Of(""),
+		Retries:  ptr.Of(0),
+		Verbose:  opt.Of(false),
+		Limits:   &Limits{Max: ptr.Of(0), Ratio: opt.Of(0.0)},
+		Defaults: ref.Of(Limits{Max: ptr.Of(0), Ratio: opt.Of(0.5)}),
+		History:  []*int{ptr.Of(0), nil},
+		ByRegion: map[string]Limits{"eu": {Max: ptr.Of(0)}},
+	}
+
+	ptr.NilZeros(&settings)
+
+	require.Equal(t, Settings{
+		Retries:  ptr.Of(0),
+		Defaults: ref.Of(Limits{Ratio: opt.Of(0.5)}),
+		History:  []*int{ptr.Of(0), nil},
+		ByRegion: map[string]Limits{"eu": {}},
+	}, settings)
+}
+
+func TestFillZeros(t *testing.T) {
+	t.Parallel()
+
+	settings := Settings{
+		Defaults: ref.Of(Limits{Ratio: opt.Of(0.5)}),
+		History:  []*int{nil},
+		ByRegion: map[string]Limits{"eu": {}},
+	}
+
+	ptr.FillZeros(&settings)
+
+	require.Equal(t, Settings{
+		Name:     ptr.Of(""),
+		Verbose:  opt.Of(false),
+		Limits:   &Limits{Max: ptr.Of(0), Ratio: opt.Of(0.0)},
+		Defaults: ref.Of(Limits{Max: ptr.Of(0), Ratio: opt.Of(0.5)}),
+		History:  []*int{nil},
+		ByRegion: map[string]Limits{"eu": {Max: ptr.Of(0), Ratio: opt.Of(0.0)}},
+	}, settings)
+
+	ptr.NilZeros(&settings)
+	require.Equal(t, Settings{
+		Defaults: ref.Of(Limits{Ratio: opt.Of(0.5)}),
+		History:  []*int{nil},
+		ByRegion: map[string]Limits{"eu": {}},
+	}, settings, "NilZeros reverts FillZeros")
+}
+
+func TestZerosRecursive(t *testing.T) {
+	t.Parallel()
+
+	var node Node
+
+	ptr.FillZeros(&node)
+	require.Equal(t, Node{}, node, "recursive types are not expanded")
+
+	loop := &Node{}
+	loop.Next = loop
+
+	ptr.NilZeros(&loop)
+	require.NotNil(t, loop, "cycles are not zero")
+	require.Same(t, loop, loop.Next)
+
+	ptr.NilZeros[Settings](nil)
+	ptr.FillZeros[Settings](nil)
+}
@@ -2,13 +2,14 @@ package ref
 
 // Of returns a new Ref based on the given value.
 func Of[T any](v T) Ref[T] {
-	return Ref[T]{&v}
+	return Ref[T]{ptr: &v}
 }
 
 // Guaranteed returns a new Ref based on the given pointer that is guaranteed to be not nil.
 // It is useful when you know that the pointer is not nil, but the compiler does not.
+// With the ptrtools_debug build tag, the caller of Guaranteed(nil) is recorded and reported by Ptr and Val.
 func Guaranteed[T any](notNilPtr *T) Ref[T] {
-	return Ref[T]{notNilPtr}
+	return Ref[T]{origin: newOrigin(notNilPtr == nil, 1), ptr: notNilPtr}
 }
 
 // FromPtr returns a new Ref based on the given pointer.
@@ -18,5 +19,5 @@ func FromPtr[T any](ptr *T) (Ref[T], error) {
 		return Ref[T]{}, ErrPtrMustBeNotNil
 	}
 
-	return Ref[T]{ptr}, nil
+	return Ref[T]{ptr: ptr}, nil
 }
@@ -0,0 +1,55 @@
+//go:build ptrtools_debug
+
+package ref
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+)
+
+// debug enables the validation of Refs, built with the ptrtools_debug tag.
+const debug = true
+
+// maxDepth limits the recorded stack of a Ref holding nil.
+const maxDepth = 32
+
+// origin records the stack where a Ref holding nil was created, a pointer keeps Ref comparable.
+type origin struct {
+	site *[]uintptr
+}
+
+// newOrigin records the stack of the caller skip frames above the function calling newOrigin,
+// nothing is recorded for not nil pointers.
+func newOrigin(isNil bool, skip int) origin {
+	if !isNil {
+		return origin{}
+	}
+
+	pcs := make([]uintptr, maxDepth)
+
+	pcs = pcs[:runtime.Callers(skip+2, pcs)] //nolint:mnd // runtime.Callers and newOrigin
+
+	return origin{site: &pcs}
+}
+
+// error describes the invalid Ref along with the place it was created.
+func (o origin) error() error {
+	if o.site == nil {
+		return fmt.Errorf("%w: zero value Ref", ErrPtrMustBeNotNil)
+	}
+
+	var sb strings.Builder
+
+	frames := runtime.CallersFrames(*o.site)
+	for {
+		frame, more := frames.Next()
+		fmt.Fprintf(&sb, "\n%s\n\t%s:%d", frame.Function, frame.File, frame.Line)
+
+		if !more {
+			break
+		}
+	}
+
+	return fmt.Errorf("%w: Ref created at:%s", ErrPtrMustBeNotNil, sb.String())
+}
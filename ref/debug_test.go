@@ -0,0 +1,75 @@
+//go:build ptrtools_debug
+
+package ref_test
+
+import (
+	"fmt"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sr9000/go-ptr-tools/ref"
+)
+
+// recovered returns the error fn panics with.
+func recovered(t *testing.T, fn func()) (err error) {
+	t.Helper()
+
+	defer func() {
+		var ok bool
+
+		err, ok = recover().(error)
+		require.True(t, ok, "expected a panic with an error")
+	}()
+
+	fn()
+
+	return nil
+}
+
+// guaranteedNil returns a Ref holding nil along with the file:line it is created at.
+func guaranteedNil() (ref.Ref[int], string) {
+	_, file, line, _ := runtime.Caller(0)
+
+	return ref.Guaranteed[int](nil), fmt.Sprintf("%s:%d", file, line+2)
+}
+
+func TestDebugGuaranteed(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+
+		x := 42
+		rx := ref.Guaranteed(&x)
+		require.Same(t, &x, rx.Ptr())
+		require.Equal(t, x, rx.Val())
+	})
+
+	t.Run("nil ptr", func(t *testing.T) {
+		t.Parallel()
+
+		rnil, site := guaranteedNil()
+
+		err := recovered(t, func() { rnil.Val() })
+		require.ErrorIs(t, err, ref.ErrPtrMustBeNotNil)
+		require.Contains(t, err.Error(), "ref_test.guaranteedNil")
+		require.Contains(t, err.Error(), site)
+
+		err = recovered(t, func() { rnil.Ptr() })
+		require.ErrorIs(t, err, ref.ErrPtrMustBeNotNil)
+		require.Contains(t, err.Error(), site)
+	})
+
+	t.Run("zero value", func(t *testing.T) {
+		t.Parallel()
+
+		rzero, fromErr := ref.FromPtr[int](nil)
+		require.ErrorIs(t, fromErr, ref.ErrPtrMustBeNotNil)
+
+		err := recovered(t, func() { rzero.Val() })
+		require.ErrorIs(t, err, ref.ErrPtrMustBeNotNil)
+		require.Contains(t, err.Error(), "zero value")
+	})
+}
@@ -0,0 +1,109 @@
+//go:build generate_monad
+
+package main
+
+import (
+	_ "embed"
+
+	"bytes"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"os/exec"
+	"strings"
+	"text/template"
+)
+
+const (
+	monadGeneratorPath = "internal/generate/generate_monad.go"
+	filename           = "monad.go"
+	viewFilename       = "view_monad.go"
+	zipFilename        = "zip.go"
+
+	ownerWritePermission = 0o644
+
+	argumentsLimit = 9
+	resultsLimit   = 5
+)
+
+var (
+	//go:embed tmpl/monad.gotmpl
+	monadRaw string
+
+	//go:embed tmpl/view.gotmpl
+	viewRaw string
+
+	//go:embed tmpl/zip.gotmpl
+	zipRaw string
+)
+
+type Variant struct {
+	N, M int
+	Ctx  bool
+	Err  bool
+}
+
+func main() {
+	funcMap := template.FuncMap{
+		"add": func(x, y int) int { return x + y },
+	}
+
+	pkg := detectPackageName()
+
+	var monads, zips []Variant
+
+	for n := 1; n <= argumentsLimit; n++ {
+		for m := 0; m <= resultsLimit; m++ {
+			monads = append(monads,
+				Variant{n, m, false, false},
+				Variant{n, m, true, false},
+				Variant{n, m, false, true},
+				Variant{n, m, true, true},
+			)
+		}
+
+		zips = append(zips, Variant{N: n, M: 1})
+	}
+
+	generate(funcMap, pkg, monadRaw, filename, true, monads)
+	generate(funcMap, pkg, viewRaw, viewFilename, true, monads)
+	generate(funcMap, pkg, zipRaw, zipFilename, false, zips)
+
+	slog.Info("done")
+}
+
+func generate(funcMap template.FuncMap, pkg, raw, filename string, withContext bool, variants []Variant) {
+	tmpl := template.Must(template.New(filename).Funcs(funcMap).Parse(raw))
+
+	var buf bytes.Buffer
+
+	buf.WriteString("// Code generated by generate_monad.go; DO NOT EDIT.\n")
+	buf.WriteString(fmt.Sprintf("package %s\n\n", pkg))
+	buf.WriteString(fmt.Sprintf("//go:generate go run %s\n", monadGeneratorPath))
+
+	if withContext {
+		buf.WriteString("import (\n\t\"context\"\n)\n")
+	}
+
+	for _, args := range variants {
+		err := tmpl.Execute(&buf, args)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	err := os.WriteFile(filename, buf.Bytes(), fs.FileMode(ownerWritePermission))
+	if err != nil {
+		panic(err)
+	}
+}
+
+func detectPackageName() string {
+	out, err := exec.Command("go", "list", "-f", "{{.Name}}").Output()
+	if err != nil {
+		panic(err)
+	}
+
+	return strings.TrimSpace(string(out))
+}
@@ -0,0 +1,103 @@
+package ref_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sr9000/go-ptr-tools/ref"
+)
+
+var errNegative = errors.New("negative number")
+
+func addCtxErr(ctx context.Context, a, b int) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	if a+b < 0 {
+		return 0, errNegative
+	}
+
+	return a + b, nil
+}
+
+func TestApply(t *testing.T) {
+	t.Parallel()
+
+	t.Run("void", func(t *testing.T) {
+		t.Parallel()
+
+		var got string
+
+		ref.ApplyVoid(ref.Of(7), func(v int) { got = strconv.Itoa(v) })
+		require.Equal(t, "7", got)
+	})
+
+	t.Run("result", func(t *testing.T) {
+		t.Parallel()
+
+		s := ref.Apply(ref.Of(7), strconv.Itoa)
+		require.Equal(t, "7", s.Val())
+
+		q, r := ref.Apply22(ref.Of(7), ref.Of(2), func(a, b int) (int, int) { return a / b, a % b })
+		require.Equal(t, 3, q.Val())
+		require.Equal(t, 1, r.Val())
+	})
+
+	t.Run("err", func(t *testing.T) {
+		t.Parallel()
+
+		n, err := ref.ApplyErr(ref.Of("42"), strconv.Atoi)
+		require.NoError(t, err)
+		require.Equal(t, 42, n.Val())
+
+		n, err = ref.ApplyErr(ref.Of("x"), strconv.Atoi)
+		require.Error(t, err)
+		require.Equal(t, ref.Ref[int]{}, n, "just like ref.FromPtr, the Ref is valid only without error")
+	})
+
+	t.Run("ctx err", func(t *testing.T) {
+		t.Parallel()
+
+		sum, err := ref.Apply2CtxErr(t.Context(), ref.Of(1), ref.Of(2), addCtxErr)
+		require.NoError(t, err)
+		require.Equal(t, 3, sum.Val())
+
+		_, err = ref.Apply2CtxErr(t.Context(), ref.Of(1), ref.Of(-2), addCtxErr)
+		require.ErrorIs(t, err, errNegative)
+
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+
+		_, err = ref.Apply2CtxErr(ctx, ref.Of(1), ref.Of(2), addCtxErr)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("results are new values", func(t *testing.T) {
+		t.Parallel()
+
+		r := ref.Of(1)
+		same := ref.Apply(r, func(v int) int { return v })
+		require.False(t, r.Equal(same))
+	})
+}
+
+func TestMonad(t *testing.T) {
+	t.Parallel()
+
+	itoa := ref.Monad(strconv.Itoa)
+	atoi := ref.MonadErr(strconv.Atoi)
+
+	n, err := atoi(itoa(ref.Of(42)))
+	require.NoError(t, err)
+	require.Equal(t, 42, n.Val())
+
+	add := ref.Monad2CtxErr(addCtxErr)
+	sum, err := add(t.Context(), n, ref.Of(8))
+	require.NoError(t, err)
+	require.Equal(t, 50, sum.Val())
+}
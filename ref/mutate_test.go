@@ -0,0 +1,102 @@
+package ref_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sr9000/go-ptr-tools/ref"
+)
+
+func TestMutation(t *testing.T) {
+	t.Parallel()
+
+	t.Run("set", func(t *testing.T) {
+		t.Parallel()
+
+		x := 1
+		r := ref.Guaranteed(&x)
+		r.Set(2)
+		require.Equal(t, 2, x)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		t.Parallel()
+
+		r := ref.Of(20)
+		require.Equal(t, 21, r.Update(func(v int) int { return v + 1 }))
+		require.Equal(t, 21, r.Val())
+	})
+
+	t.Run("update ptr", func(t *testing.T) {
+		t.Parallel()
+
+		r := ref.Of(account{Name: "alice", Balance: 10})
+		r.UpdatePtr(func(a *account) { a.Balance += 5 })
+		require.Equal(t, account{Name: "alice", Balance: 15}, r.Val())
+	})
+
+	t.Run("swap", func(t *testing.T) {
+		t.Parallel()
+
+		r := ref.Of("old")
+		require.Equal(t, "old", r.Swap("new"))
+		require.Equal(t, "new", r.Val())
+	})
+
+	t.Run("copies share the value", func(t *testing.T) {
+		t.Parallel()
+
+		r := ref.Of(1)
+		c := r
+		c.Set(3)
+		require.Equal(t, 3, r.Val())
+	})
+}
+
+func TestEqual(t *testing.T) {
+	t.Parallel()
+
+	x, y := 1, 1
+	rx := ref.Guaranteed(&x)
+
+	require.True(t, rx.Equal(rx))
+	require.True(t, rx.Equal(ref.Guaranteed(&x)))
+	require.False(t, rx.Equal(ref.Guaranteed(&y)), "equal values at different addresses")
+	require.False(t, ref.Of(1).Equal(ref.Of(1)))
+}
+
+func TestMap(t *testing.T) {
+	t.Parallel()
+
+	r := ref.Of(42)
+	s := ref.Map(r, strconv.Itoa)
+	require.Equal(t, "42", s.Val())
+
+	r.Set(43)
+	require.Equal(t, "42", s.Val(), "results are new values")
+}
+
+func TestZip(t *testing.T) {
+	t.Parallel()
+
+	sum := ref.Zip2(ref.Of(1), ref.Of(2), func(a, b int) int { return a + b })
+	require.Equal(t, 3, sum.Val())
+
+	joined := ref.Zip3(ref.Of("a"), ref.Of(2), ref.Of(true), func(s string, n int, upper bool) string {
+		s = strings.Repeat(s, n)
+		if upper {
+			s = strings.ToUpper(s)
+		}
+
+		return s
+	})
+	require.Equal(t, "AA", joined.Val())
+
+	require.Equal(t, 45, ref.Zip9(
+		ref.Of(1), ref.Of(2), ref.Of(3), ref.Of(4), ref.Of(5), ref.Of(6), ref.Of(7), ref.Of(8), ref.Of(9),
+		func(a, b, c, d, e, f, g, h, i int) int { return a + b + c + d + e + f + g + h + i },
+	).Val())
+}
@@ -0,0 +1,17 @@
+//go:build !ptrtools_debug
+
+package ref
+
+// debug enables the validation of Refs, built with the ptrtools_debug tag.
+const debug = false
+
+// origin takes no space in normal builds.
+type origin struct{}
+
+func newOrigin(bool, int) origin {
+	return origin{}
+}
+
+func (origin) error() error {
+	return nil
+}
@@ -0,0 +1,43 @@
+//go:build !ptrtools_debug
+
+package ref_test
+
+import (
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sr9000/go-ptr-tools/ref"
+)
+
+func TestGuaranteedNil(t *testing.T) {
+	t.Parallel()
+
+	require.NotPanics(t, func() {
+		rnil := ref.Guaranteed[int](nil)
+		require.Nil(t, rnil.Ptr())
+	})
+}
+
+func TestLayout(t *testing.T) { //nolint:paralleltest // AllocsPerRun panics in parallel tests
+	require.Equal(t, unsafe.Sizeof((*int)(nil)), unsafe.Sizeof(ref.Ref[int]{}))
+	require.Equal(t, unsafe.Alignof((*int)(nil)), unsafe.Alignof(ref.Ref[int]{}))
+
+	x := 42
+	allocs := testing.AllocsPerRun(100, func() {
+		r := ref.Guaranteed(&x)
+		*r.Ptr()++
+		x = r.Val() - 1
+	})
+	require.Zero(t, allocs)
+}
+
+func BenchmarkGuaranteedNil(b *testing.B) {
+	b.ReportAllocs()
+	b.ReportMetric(float64(unsafe.Sizeof(ref.Ref[int]{})), "B/ref")
+
+	for range b.N {
+		_ = ref.Guaranteed[int](nil).Ptr()
+	}
+}
@@ -1,18 +1,69 @@
 // Package ref provides a reference type Ref that can be used to store an "always valid pointer".
+//
+// Build with the ptrtools_debug tag to validate Refs: Guaranteed records where a Ref holding nil was created,
+// and Ptr and Val of such Refs (or zero value ones) panic with an error wrapping ErrPtrMustBeNotNil
+// that includes the creation site. Normal builds keep Ref as small as a bare pointer.
 package ref
 
 // Ref must be used as mental anchor for "always valid pointer" guarantees.
 type Ref[T any] struct {
-	ptr *T
+	origin origin // goes first, so it takes no space in normal builds
+	ptr    *T
 }
 
 // Ptr returns the pointer to the value.
 // There is no need to check for nil, because the pointer is guaranteed to be not nil.
 func (r Ref[T]) Ptr() *T {
+	if debug && r.ptr == nil {
+		panic(r.origin.error())
+	}
+
 	return r.ptr
 }
 
 // Val returns the value itself.
 func (r Ref[T]) Val() T {
+	if debug && r.ptr == nil {
+		panic(r.origin.error())
+	}
+
 	return *r.ptr
 }
+
+// Set replaces the referenced value, the change is visible through every copy of the Ref.
+func (r Ref[T]) Set(v T) {
+	*r.Ptr() = v
+}
+
+// Update replaces the referenced value with the result of fn and returns the new value.
+func (r Ref[T]) Update(fn func(T) T) T {
+	ptr := r.Ptr()
+	*ptr = fn(*ptr)
+
+	return *ptr
+}
+
+// UpdatePtr lets fn modify the referenced value in place, e.g. a single field of a large struct.
+func (r Ref[T]) UpdatePtr(fn func(*T)) {
+	fn(r.Ptr())
+}
+
+// Swap replaces the referenced value and returns the previous one.
+func (r Ref[T]) Swap(v T) T {
+	ptr := r.Ptr()
+	old := *ptr
+	*ptr = v
+
+	return old
+}
+
+// Equal tells whether both Refs point to the same address, not whether the values are equal.
+func (r Ref[T]) Equal(other Ref[T]) bool {
+	return r.ptr == other.ptr
+}
+
+// Get returns the value and true, a Ref is always present.
+// It lets Ref be used where an optional value is expected, e.g. by the maybe package.
+func (r Ref[T]) Get() (T, bool) {
+	return r.Val(), true
+}
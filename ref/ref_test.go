@@ -10,6 +10,8 @@ import (
 
 func BenchmarkToFromPtr(b *testing.B) {
 	b.Run("ref", func(b *testing.B) {
+		b.ReportAllocs()
+
 		for i := range b.N / 2 {
 			r := ref.Guaranteed(&i)
 			*r.Ptr()++
@@ -18,6 +20,8 @@ func BenchmarkToFromPtr(b *testing.B) {
 	})
 
 	b.Run("bare ptr", func(b *testing.B) {
+		b.ReportAllocs()
+
 		for i := range b.N / 2 {
 			ptr := &i
 			*ptr++
@@ -60,15 +64,6 @@ func TestGuaranteed(t *testing.T) {
 		require.Same(t, &x, rx.Ptr())
 		require.Equal(t, x, rx.Val())
 	})
-
-	t.Run("nil ptr", func(t *testing.T) {
-		t.Parallel()
-
-		require.NotPanics(t, func() {
-			rnil := ref.Guaranteed[int](nil)
-			require.Nil(t, rnil.Ptr())
-		})
-	})
 }
 
 func TestOf(t *testing.T) {
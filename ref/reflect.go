@@ -0,0 +1,46 @@
+package ref
+
+import (
+	"reflect"
+	"strings"
+)
+
+// reflected is implemented by every Ref[T] and lets reflection-based tools handle Ref without knowing T.
+type reflected interface {
+	reflectPtr() reflect.Value
+}
+
+// reflectedSetter is implemented by every *Ref[T].
+type reflectedSetter interface {
+	reflectSet(ptr reflect.Value)
+}
+
+//nolint:gochecknoglobals // package path of reflected Ref types
+var pkgPath = reflect.TypeFor[Ref[struct{}]]().PkgPath()
+
+// IsRefType reports whether t is an instantiation of Ref.
+// Structs embedding a Ref are not: they have the promoted methods of Ref, but other fields too.
+func IsRefType(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && t.PkgPath() == pkgPath && strings.HasPrefix(t.Name(), "Ref[")
+}
+
+// ReflectPtr returns the reflected pointer held by the Ref.
+// It panics if v does not hold a Ref, see IsRefType.
+func ReflectPtr(v reflect.Value) reflect.Value {
+	return v.Interface().(reflected).reflectPtr() //nolint:forcetypeassert // documented panic
+}
+
+// ReflectSet stores the reflected pointer ptr into the addressable reflected Ref dst.
+// Just like Guaranteed, it is up to the caller to pass a not nil pointer.
+// It panics if dst does not hold a Ref or ptr is not assignable to its pointer type.
+func ReflectSet(dst, ptr reflect.Value) {
+	dst.Addr().Interface().(reflectedSetter).reflectSet(ptr) //nolint:forcetypeassert // documented panic
+}
+
+func (r Ref[T]) reflectPtr() reflect.Value {
+	return reflect.ValueOf(r.ptr)
+}
+
+func (r *Ref[T]) reflectSet(ptr reflect.Value) {
+	reflect.ValueOf(&r.ptr).Elem().Set(ptr)
+}
@@ -0,0 +1,36 @@
+package ref_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sr9000/go-ptr-tools/ref"
+)
+
+func TestIsRefType(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, ref.IsRefType(reflect.TypeFor[ref.Ref[int]]()))
+	require.False(t, ref.IsRefType(reflect.TypeFor[*int]()))
+	require.False(t, ref.IsRefType(reflect.TypeFor[struct{ P *int }]()))
+	require.False(t, ref.IsRefType(reflect.TypeFor[struct {
+		ref.Ref[int]
+
+		Name string
+	}]()))
+}
+
+func TestReflectPtrSet(t *testing.T) {
+	t.Parallel()
+
+	x, y := 1, 2
+	rx := ref.Guaranteed(&x)
+	rv := reflect.ValueOf(&rx).Elem()
+
+	require.Same(t, &x, ref.ReflectPtr(rv).Interface())
+
+	ref.ReflectSet(rv, reflect.ValueOf(&y))
+	require.Same(t, &y, rx.Ptr())
+}
@@ -0,0 +1,25 @@
+package ref
+
+// View is a read-only Ref: it always holds a value just like Ref, but exposes no pointer to modify it.
+// Use it in signatures of functions that only read shared data, the way Rust borrows &T immutably
+// while Ref[T] stands for &mut T. Only the viewed value itself is protected:
+// pointers, slices and maps inside it are shared as usual.
+type View[T any] struct {
+	ref Ref[T]
+}
+
+// View returns the read-only view of the referenced value.
+func (r Ref[T]) View() View[T] {
+	return View[T]{r}
+}
+
+// Val returns a copy of the viewed value.
+func (v View[T]) Val() T {
+	return v.ref.Val()
+}
+
+// Field returns the read-only view of a field (or any part) of the viewed value without copying the value.
+// The field function must return a not nil pointer into its argument, e.g. func(u *User) *string { return &u.Name }.
+func Field[S, F any](v View[S], field func(s *S) *F) View[F] {
+	return View[F]{Guaranteed(field(v.ref.Ptr()))}
+}
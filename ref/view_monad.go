@@ -0,0 +1,3877 @@
+// Code generated by generate_monad.go; DO NOT EDIT.
+package ref
+
+//go:generate go run internal/generate/generate_monad.go
+import (
+	"context"
+)
+
+func ApplyViewVoid[T1 any](
+	t1 View[T1],
+	fn func(t1 T1),
+) {
+	fn(t1.Val())
+}
+
+func MonadViewVoid[T1 any](
+	fn func(t1 T1),
+) func(t1 View[T1]) {
+	return func(t1 View[T1]) {
+		ApplyViewVoid(t1, fn)
+	}
+}
+
+func ApplyViewVoidCtx[T1 any](
+	ctx context.Context, t1 View[T1],
+	fn func(ctx context.Context, t1 T1),
+) {
+	fn(ctx, t1.Val())
+}
+
+func MonadViewVoidCtx[T1 any](
+	fn func(ctx context.Context, t1 T1),
+) func(ctx context.Context, t1 View[T1]) {
+	return func(ctx context.Context, t1 View[T1]) {
+		ApplyViewVoidCtx(ctx, t1, fn)
+	}
+}
+
+func ApplyViewVoidErr[T1 any](
+	t1 View[T1],
+	fn func(t1 T1) (err error),
+) (err error) {
+	return fn(t1.Val())
+}
+
+func MonadViewVoidErr[T1 any](
+	fn func(t1 T1) (err error),
+) func(t1 View[T1]) (err error) {
+	return func(t1 View[T1]) (err error) {
+		return ApplyViewVoidErr(t1, fn)
+	}
+}
+
+func ApplyViewVoidCtxErr[T1 any](
+	ctx context.Context, t1 View[T1],
+	fn func(ctx context.Context, t1 T1) (err error),
+) (err error) {
+	return fn(ctx, t1.Val())
+}
+
+func MonadViewVoidCtxErr[T1 any](
+	fn func(ctx context.Context, t1 T1) (err error),
+) func(ctx context.Context, t1 View[T1]) (err error) {
+	return func(ctx context.Context, t1 View[T1]) (err error) {
+		return ApplyViewVoidCtxErr(ctx, t1, fn)
+	}
+}
+
+func ApplyView[R1, T1 any](
+	t1 View[T1],
+	fn func(t1 T1) (r1 R1),
+) (r1 View[R1]) {
+	x1 := fn(t1.Val())
+
+	return Of(x1).View()
+}
+
+func MonadView[R1, T1 any](
+	fn func(t1 T1) (r1 R1),
+) func(t1 View[T1]) (r1 View[R1]) {
+	return func(t1 View[T1]) (r1 View[R1]) {
+		return ApplyView(t1, fn)
+	}
+}
+
+func ApplyViewCtx[R1, T1 any](
+	ctx context.Context, t1 View[T1],
+	fn func(ctx context.Context, t1 T1) (r1 R1),
+) (r1 View[R1]) {
+	x1 := fn(ctx, t1.Val())
+
+	return Of(x1).View()
+}
+
+func MonadViewCtx[R1, T1 any](
+	fn func(ctx context.Context, t1 T1) (r1 R1),
+) func(ctx context.Context, t1 View[T1]) (r1 View[R1]) {
+	return func(ctx context.Context, t1 View[T1]) (r1 View[R1]) {
+		return ApplyViewCtx(ctx, t1, fn)
+	}
+}
+
+func ApplyViewErr[R1, T1 any](
+	t1 View[T1],
+	fn func(t1 T1) (r1 R1, err error),
+) (r1 View[R1], err error) {
+	x1, err := fn(t1.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), nil
+}
+
+func MonadViewErr[R1, T1 any](
+	fn func(t1 T1) (r1 R1, err error),
+) func(t1 View[T1]) (r1 View[R1], err error) {
+	return func(t1 View[T1]) (r1 View[R1], err error) {
+		return ApplyViewErr(t1, fn)
+	}
+}
+
+func ApplyViewCtxErr[R1, T1 any](
+	ctx context.Context, t1 View[T1],
+	fn func(ctx context.Context, t1 T1) (r1 R1, err error),
+) (r1 View[R1], err error) {
+	x1, err := fn(ctx, t1.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), nil
+}
+
+func MonadViewCtxErr[R1, T1 any](
+	fn func(ctx context.Context, t1 T1) (r1 R1, err error),
+) func(ctx context.Context, t1 View[T1]) (r1 View[R1], err error) {
+	return func(ctx context.Context, t1 View[T1]) (r1 View[R1], err error) {
+		return ApplyViewCtxErr(ctx, t1, fn)
+	}
+}
+
+func ApplyView12[R1, R2, T1 any](
+	t1 View[T1],
+	fn func(t1 T1) (r1 R1, r2 R2),
+) (r1 View[R1], r2 View[R2]) {
+	x1, x2 := fn(t1.Val())
+
+	return Of(x1).View(), Of(x2).View()
+}
+
+func MonadView12[R1, R2, T1 any](
+	fn func(t1 T1) (r1 R1, r2 R2),
+) func(t1 View[T1]) (r1 View[R1], r2 View[R2]) {
+	return func(t1 View[T1]) (r1 View[R1], r2 View[R2]) {
+		return ApplyView12(t1, fn)
+	}
+}
+
+func ApplyView12Ctx[R1, R2, T1 any](
+	ctx context.Context, t1 View[T1],
+	fn func(ctx context.Context, t1 T1) (r1 R1, r2 R2),
+) (r1 View[R1], r2 View[R2]) {
+	x1, x2 := fn(ctx, t1.Val())
+
+	return Of(x1).View(), Of(x2).View()
+}
+
+func MonadView12Ctx[R1, R2, T1 any](
+	fn func(ctx context.Context, t1 T1) (r1 R1, r2 R2),
+) func(ctx context.Context, t1 View[T1]) (r1 View[R1], r2 View[R2]) {
+	return func(ctx context.Context, t1 View[T1]) (r1 View[R1], r2 View[R2]) {
+		return ApplyView12Ctx(ctx, t1, fn)
+	}
+}
+
+func ApplyView12Err[R1, R2, T1 any](
+	t1 View[T1],
+	fn func(t1 T1) (r1 R1, r2 R2, err error),
+) (r1 View[R1], r2 View[R2], err error) {
+	x1, x2, err := fn(t1.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), nil
+}
+
+func MonadView12Err[R1, R2, T1 any](
+	fn func(t1 T1) (r1 R1, r2 R2, err error),
+) func(t1 View[T1]) (r1 View[R1], r2 View[R2], err error) {
+	return func(t1 View[T1]) (r1 View[R1], r2 View[R2], err error) {
+		return ApplyView12Err(t1, fn)
+	}
+}
+
+func ApplyView12CtxErr[R1, R2, T1 any](
+	ctx context.Context, t1 View[T1],
+	fn func(ctx context.Context, t1 T1) (r1 R1, r2 R2, err error),
+) (r1 View[R1], r2 View[R2], err error) {
+	x1, x2, err := fn(ctx, t1.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), nil
+}
+
+func MonadView12CtxErr[R1, R2, T1 any](
+	fn func(ctx context.Context, t1 T1) (r1 R1, r2 R2, err error),
+) func(ctx context.Context, t1 View[T1]) (r1 View[R1], r2 View[R2], err error) {
+	return func(ctx context.Context, t1 View[T1]) (r1 View[R1], r2 View[R2], err error) {
+		return ApplyView12CtxErr(ctx, t1, fn)
+	}
+}
+
+func ApplyView13[R1, R2, R3, T1 any](
+	t1 View[T1],
+	fn func(t1 T1) (r1 R1, r2 R2, r3 R3),
+) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+	x1, x2, x3 := fn(t1.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View()
+}
+
+func MonadView13[R1, R2, R3, T1 any](
+	fn func(t1 T1) (r1 R1, r2 R2, r3 R3),
+) func(t1 View[T1]) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+	return func(t1 View[T1]) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+		return ApplyView13(t1, fn)
+	}
+}
+
+func ApplyView13Ctx[R1, R2, R3, T1 any](
+	ctx context.Context, t1 View[T1],
+	fn func(ctx context.Context, t1 T1) (r1 R1, r2 R2, r3 R3),
+) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+	x1, x2, x3 := fn(ctx, t1.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View()
+}
+
+func MonadView13Ctx[R1, R2, R3, T1 any](
+	fn func(ctx context.Context, t1 T1) (r1 R1, r2 R2, r3 R3),
+) func(ctx context.Context, t1 View[T1]) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+	return func(ctx context.Context, t1 View[T1]) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+		return ApplyView13Ctx(ctx, t1, fn)
+	}
+}
+
+func ApplyView13Err[R1, R2, R3, T1 any](
+	t1 View[T1],
+	fn func(t1 T1) (r1 R1, r2 R2, r3 R3, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+	x1, x2, x3, err := fn(t1.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), nil
+}
+
+func MonadView13Err[R1, R2, R3, T1 any](
+	fn func(t1 T1) (r1 R1, r2 R2, r3 R3, err error),
+) func(t1 View[T1]) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+	return func(t1 View[T1]) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+		return ApplyView13Err(t1, fn)
+	}
+}
+
+func ApplyView13CtxErr[R1, R2, R3, T1 any](
+	ctx context.Context, t1 View[T1],
+	fn func(ctx context.Context, t1 T1) (r1 R1, r2 R2, r3 R3, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+	x1, x2, x3, err := fn(ctx, t1.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), nil
+}
+
+func MonadView13CtxErr[R1, R2, R3, T1 any](
+	fn func(ctx context.Context, t1 T1) (r1 R1, r2 R2, r3 R3, err error),
+) func(ctx context.Context, t1 View[T1]) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+	return func(ctx context.Context, t1 View[T1]) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+		return ApplyView13CtxErr(ctx, t1, fn)
+	}
+}
+
+func ApplyView14[R1, R2, R3, R4, T1 any](
+	t1 View[T1],
+	fn func(t1 T1) (r1 R1, r2 R2, r3 R3, r4 R4),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+	x1, x2, x3, x4 := fn(t1.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View()
+}
+
+func MonadView14[R1, R2, R3, R4, T1 any](
+	fn func(t1 T1) (r1 R1, r2 R2, r3 R3, r4 R4),
+) func(t1 View[T1]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+	return func(t1 View[T1]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+		return ApplyView14(t1, fn)
+	}
+}
+
+func ApplyView14Ctx[R1, R2, R3, R4, T1 any](
+	ctx context.Context, t1 View[T1],
+	fn func(ctx context.Context, t1 T1) (r1 R1, r2 R2, r3 R3, r4 R4),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+	x1, x2, x3, x4 := fn(ctx, t1.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View()
+}
+
+func MonadView14Ctx[R1, R2, R3, R4, T1 any](
+	fn func(ctx context.Context, t1 T1) (r1 R1, r2 R2, r3 R3, r4 R4),
+) func(ctx context.Context, t1 View[T1]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+	return func(ctx context.Context, t1 View[T1]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+		return ApplyView14Ctx(ctx, t1, fn)
+	}
+}
+
+func ApplyView14Err[R1, R2, R3, R4, T1 any](
+	t1 View[T1],
+	fn func(t1 T1) (r1 R1, r2 R2, r3 R3, r4 R4, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+	x1, x2, x3, x4, err := fn(t1.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), nil
+}
+
+func MonadView14Err[R1, R2, R3, R4, T1 any](
+	fn func(t1 T1) (r1 R1, r2 R2, r3 R3, r4 R4, err error),
+) func(t1 View[T1]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+	return func(t1 View[T1]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+		return ApplyView14Err(t1, fn)
+	}
+}
+
+func ApplyView14CtxErr[R1, R2, R3, R4, T1 any](
+	ctx context.Context, t1 View[T1],
+	fn func(ctx context.Context, t1 T1) (r1 R1, r2 R2, r3 R3, r4 R4, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+	x1, x2, x3, x4, err := fn(ctx, t1.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), nil
+}
+
+func MonadView14CtxErr[R1, R2, R3, R4, T1 any](
+	fn func(ctx context.Context, t1 T1) (r1 R1, r2 R2, r3 R3, r4 R4, err error),
+) func(ctx context.Context, t1 View[T1]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+	return func(ctx context.Context, t1 View[T1]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+		return ApplyView14CtxErr(ctx, t1, fn)
+	}
+}
+
+func ApplyView15[R1, R2, R3, R4, R5, T1 any](
+	t1 View[T1],
+	fn func(t1 T1) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+	x1, x2, x3, x4, x5 := fn(t1.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), Of(x5).View()
+}
+
+func MonadView15[R1, R2, R3, R4, R5, T1 any](
+	fn func(t1 T1) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5),
+) func(t1 View[T1]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+	return func(t1 View[T1]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+		return ApplyView15(t1, fn)
+	}
+}
+
+func ApplyView15Ctx[R1, R2, R3, R4, R5, T1 any](
+	ctx context.Context, t1 View[T1],
+	fn func(ctx context.Context, t1 T1) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+	x1, x2, x3, x4, x5 := fn(ctx, t1.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), Of(x5).View()
+}
+
+func MonadView15Ctx[R1, R2, R3, R4, R5, T1 any](
+	fn func(ctx context.Context, t1 T1) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5),
+) func(ctx context.Context, t1 View[T1]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+	return func(ctx context.Context, t1 View[T1]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+		return ApplyView15Ctx(ctx, t1, fn)
+	}
+}
+
+func ApplyView15Err[R1, R2, R3, R4, R5, T1 any](
+	t1 View[T1],
+	fn func(t1 T1) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+	x1, x2, x3, x4, x5, err := fn(t1.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), Of(x5).View(), nil
+}
+
+func MonadView15Err[R1, R2, R3, R4, R5, T1 any](
+	fn func(t1 T1) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error),
+) func(t1 View[T1]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+	return func(t1 View[T1]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+		return ApplyView15Err(t1, fn)
+	}
+}
+
+func ApplyView15CtxErr[R1, R2, R3, R4, R5, T1 any](
+	ctx context.Context, t1 View[T1],
+	fn func(ctx context.Context, t1 T1) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+	x1, x2, x3, x4, x5, err := fn(ctx, t1.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), Of(x5).View(), nil
+}
+
+func MonadView15CtxErr[R1, R2, R3, R4, R5, T1 any](
+	fn func(ctx context.Context, t1 T1) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error),
+) func(ctx context.Context, t1 View[T1]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+	return func(ctx context.Context, t1 View[T1]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+		return ApplyView15CtxErr(ctx, t1, fn)
+	}
+}
+
+func ApplyView2Void[T1, T2 any](
+	t1 View[T1], t2 View[T2],
+	fn func(t1 T1, t2 T2),
+) {
+	fn(t1.Val(), t2.Val())
+}
+
+func MonadView2Void[T1, T2 any](
+	fn func(t1 T1, t2 T2),
+) func(t1 View[T1], t2 View[T2]) {
+	return func(t1 View[T1], t2 View[T2]) {
+		ApplyView2Void(t1, t2, fn)
+	}
+}
+
+func ApplyView2VoidCtx[T1, T2 any](
+	ctx context.Context, t1 View[T1], t2 View[T2],
+	fn func(ctx context.Context, t1 T1, t2 T2),
+) {
+	fn(ctx, t1.Val(), t2.Val())
+}
+
+func MonadView2VoidCtx[T1, T2 any](
+	fn func(ctx context.Context, t1 T1, t2 T2),
+) func(ctx context.Context, t1 View[T1], t2 View[T2]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2]) {
+		ApplyView2VoidCtx(ctx, t1, t2, fn)
+	}
+}
+
+func ApplyView2VoidErr[T1, T2 any](
+	t1 View[T1], t2 View[T2],
+	fn func(t1 T1, t2 T2) (err error),
+) (err error) {
+	return fn(t1.Val(), t2.Val())
+}
+
+func MonadView2VoidErr[T1, T2 any](
+	fn func(t1 T1, t2 T2) (err error),
+) func(t1 View[T1], t2 View[T2]) (err error) {
+	return func(t1 View[T1], t2 View[T2]) (err error) {
+		return ApplyView2VoidErr(t1, t2, fn)
+	}
+}
+
+func ApplyView2VoidCtxErr[T1, T2 any](
+	ctx context.Context, t1 View[T1], t2 View[T2],
+	fn func(ctx context.Context, t1 T1, t2 T2) (err error),
+) (err error) {
+	return fn(ctx, t1.Val(), t2.Val())
+}
+
+func MonadView2VoidCtxErr[T1, T2 any](
+	fn func(ctx context.Context, t1 T1, t2 T2) (err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2]) (err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2]) (err error) {
+		return ApplyView2VoidCtxErr(ctx, t1, t2, fn)
+	}
+}
+
+func ApplyView2[R1, T1, T2 any](
+	t1 View[T1], t2 View[T2],
+	fn func(t1 T1, t2 T2) (r1 R1),
+) (r1 View[R1]) {
+	x1 := fn(t1.Val(), t2.Val())
+
+	return Of(x1).View()
+}
+
+func MonadView2[R1, T1, T2 any](
+	fn func(t1 T1, t2 T2) (r1 R1),
+) func(t1 View[T1], t2 View[T2]) (r1 View[R1]) {
+	return func(t1 View[T1], t2 View[T2]) (r1 View[R1]) {
+		return ApplyView2(t1, t2, fn)
+	}
+}
+
+func ApplyView2Ctx[R1, T1, T2 any](
+	ctx context.Context, t1 View[T1], t2 View[T2],
+	fn func(ctx context.Context, t1 T1, t2 T2) (r1 R1),
+) (r1 View[R1]) {
+	x1 := fn(ctx, t1.Val(), t2.Val())
+
+	return Of(x1).View()
+}
+
+func MonadView2Ctx[R1, T1, T2 any](
+	fn func(ctx context.Context, t1 T1, t2 T2) (r1 R1),
+) func(ctx context.Context, t1 View[T1], t2 View[T2]) (r1 View[R1]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2]) (r1 View[R1]) {
+		return ApplyView2Ctx(ctx, t1, t2, fn)
+	}
+}
+
+func ApplyView2Err[R1, T1, T2 any](
+	t1 View[T1], t2 View[T2],
+	fn func(t1 T1, t2 T2) (r1 R1, err error),
+) (r1 View[R1], err error) {
+	x1, err := fn(t1.Val(), t2.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), nil
+}
+
+func MonadView2Err[R1, T1, T2 any](
+	fn func(t1 T1, t2 T2) (r1 R1, err error),
+) func(t1 View[T1], t2 View[T2]) (r1 View[R1], err error) {
+	return func(t1 View[T1], t2 View[T2]) (r1 View[R1], err error) {
+		return ApplyView2Err(t1, t2, fn)
+	}
+}
+
+func ApplyView2CtxErr[R1, T1, T2 any](
+	ctx context.Context, t1 View[T1], t2 View[T2],
+	fn func(ctx context.Context, t1 T1, t2 T2) (r1 R1, err error),
+) (r1 View[R1], err error) {
+	x1, err := fn(ctx, t1.Val(), t2.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), nil
+}
+
+func MonadView2CtxErr[R1, T1, T2 any](
+	fn func(ctx context.Context, t1 T1, t2 T2) (r1 R1, err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2]) (r1 View[R1], err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2]) (r1 View[R1], err error) {
+		return ApplyView2CtxErr(ctx, t1, t2, fn)
+	}
+}
+
+func ApplyView22[R1, R2, T1, T2 any](
+	t1 View[T1], t2 View[T2],
+	fn func(t1 T1, t2 T2) (r1 R1, r2 R2),
+) (r1 View[R1], r2 View[R2]) {
+	x1, x2 := fn(t1.Val(), t2.Val())
+
+	return Of(x1).View(), Of(x2).View()
+}
+
+func MonadView22[R1, R2, T1, T2 any](
+	fn func(t1 T1, t2 T2) (r1 R1, r2 R2),
+) func(t1 View[T1], t2 View[T2]) (r1 View[R1], r2 View[R2]) {
+	return func(t1 View[T1], t2 View[T2]) (r1 View[R1], r2 View[R2]) {
+		return ApplyView22(t1, t2, fn)
+	}
+}
+
+func ApplyView22Ctx[R1, R2, T1, T2 any](
+	ctx context.Context, t1 View[T1], t2 View[T2],
+	fn func(ctx context.Context, t1 T1, t2 T2) (r1 R1, r2 R2),
+) (r1 View[R1], r2 View[R2]) {
+	x1, x2 := fn(ctx, t1.Val(), t2.Val())
+
+	return Of(x1).View(), Of(x2).View()
+}
+
+func MonadView22Ctx[R1, R2, T1, T2 any](
+	fn func(ctx context.Context, t1 T1, t2 T2) (r1 R1, r2 R2),
+) func(ctx context.Context, t1 View[T1], t2 View[T2]) (r1 View[R1], r2 View[R2]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2]) (r1 View[R1], r2 View[R2]) {
+		return ApplyView22Ctx(ctx, t1, t2, fn)
+	}
+}
+
+func ApplyView22Err[R1, R2, T1, T2 any](
+	t1 View[T1], t2 View[T2],
+	fn func(t1 T1, t2 T2) (r1 R1, r2 R2, err error),
+) (r1 View[R1], r2 View[R2], err error) {
+	x1, x2, err := fn(t1.Val(), t2.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), nil
+}
+
+func MonadView22Err[R1, R2, T1, T2 any](
+	fn func(t1 T1, t2 T2) (r1 R1, r2 R2, err error),
+) func(t1 View[T1], t2 View[T2]) (r1 View[R1], r2 View[R2], err error) {
+	return func(t1 View[T1], t2 View[T2]) (r1 View[R1], r2 View[R2], err error) {
+		return ApplyView22Err(t1, t2, fn)
+	}
+}
+
+func ApplyView22CtxErr[R1, R2, T1, T2 any](
+	ctx context.Context, t1 View[T1], t2 View[T2],
+	fn func(ctx context.Context, t1 T1, t2 T2) (r1 R1, r2 R2, err error),
+) (r1 View[R1], r2 View[R2], err error) {
+	x1, x2, err := fn(ctx, t1.Val(), t2.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), nil
+}
+
+func MonadView22CtxErr[R1, R2, T1, T2 any](
+	fn func(ctx context.Context, t1 T1, t2 T2) (r1 R1, r2 R2, err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2]) (r1 View[R1], r2 View[R2], err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2]) (r1 View[R1], r2 View[R2], err error) {
+		return ApplyView22CtxErr(ctx, t1, t2, fn)
+	}
+}
+
+func ApplyView23[R1, R2, R3, T1, T2 any](
+	t1 View[T1], t2 View[T2],
+	fn func(t1 T1, t2 T2) (r1 R1, r2 R2, r3 R3),
+) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+	x1, x2, x3 := fn(t1.Val(), t2.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View()
+}
+
+func MonadView23[R1, R2, R3, T1, T2 any](
+	fn func(t1 T1, t2 T2) (r1 R1, r2 R2, r3 R3),
+) func(t1 View[T1], t2 View[T2]) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+	return func(t1 View[T1], t2 View[T2]) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+		return ApplyView23(t1, t2, fn)
+	}
+}
+
+func ApplyView23Ctx[R1, R2, R3, T1, T2 any](
+	ctx context.Context, t1 View[T1], t2 View[T2],
+	fn func(ctx context.Context, t1 T1, t2 T2) (r1 R1, r2 R2, r3 R3),
+) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+	x1, x2, x3 := fn(ctx, t1.Val(), t2.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View()
+}
+
+func MonadView23Ctx[R1, R2, R3, T1, T2 any](
+	fn func(ctx context.Context, t1 T1, t2 T2) (r1 R1, r2 R2, r3 R3),
+) func(ctx context.Context, t1 View[T1], t2 View[T2]) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2]) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+		return ApplyView23Ctx(ctx, t1, t2, fn)
+	}
+}
+
+func ApplyView23Err[R1, R2, R3, T1, T2 any](
+	t1 View[T1], t2 View[T2],
+	fn func(t1 T1, t2 T2) (r1 R1, r2 R2, r3 R3, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+	x1, x2, x3, err := fn(t1.Val(), t2.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), nil
+}
+
+func MonadView23Err[R1, R2, R3, T1, T2 any](
+	fn func(t1 T1, t2 T2) (r1 R1, r2 R2, r3 R3, err error),
+) func(t1 View[T1], t2 View[T2]) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+	return func(t1 View[T1], t2 View[T2]) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+		return ApplyView23Err(t1, t2, fn)
+	}
+}
+
+func ApplyView23CtxErr[R1, R2, R3, T1, T2 any](
+	ctx context.Context, t1 View[T1], t2 View[T2],
+	fn func(ctx context.Context, t1 T1, t2 T2) (r1 R1, r2 R2, r3 R3, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+	x1, x2, x3, err := fn(ctx, t1.Val(), t2.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), nil
+}
+
+func MonadView23CtxErr[R1, R2, R3, T1, T2 any](
+	fn func(ctx context.Context, t1 T1, t2 T2) (r1 R1, r2 R2, r3 R3, err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2]) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2]) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+		return ApplyView23CtxErr(ctx, t1, t2, fn)
+	}
+}
+
+func ApplyView24[R1, R2, R3, R4, T1, T2 any](
+	t1 View[T1], t2 View[T2],
+	fn func(t1 T1, t2 T2) (r1 R1, r2 R2, r3 R3, r4 R4),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+	x1, x2, x3, x4 := fn(t1.Val(), t2.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View()
+}
+
+func MonadView24[R1, R2, R3, R4, T1, T2 any](
+	fn func(t1 T1, t2 T2) (r1 R1, r2 R2, r3 R3, r4 R4),
+) func(t1 View[T1], t2 View[T2]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+	return func(t1 View[T1], t2 View[T2]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+		return ApplyView24(t1, t2, fn)
+	}
+}
+
+func ApplyView24Ctx[R1, R2, R3, R4, T1, T2 any](
+	ctx context.Context, t1 View[T1], t2 View[T2],
+	fn func(ctx context.Context, t1 T1, t2 T2) (r1 R1, r2 R2, r3 R3, r4 R4),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+	x1, x2, x3, x4 := fn(ctx, t1.Val(), t2.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View()
+}
+
+func MonadView24Ctx[R1, R2, R3, R4, T1, T2 any](
+	fn func(ctx context.Context, t1 T1, t2 T2) (r1 R1, r2 R2, r3 R3, r4 R4),
+) func(ctx context.Context, t1 View[T1], t2 View[T2]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+		return ApplyView24Ctx(ctx, t1, t2, fn)
+	}
+}
+
+func ApplyView24Err[R1, R2, R3, R4, T1, T2 any](
+	t1 View[T1], t2 View[T2],
+	fn func(t1 T1, t2 T2) (r1 R1, r2 R2, r3 R3, r4 R4, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+	x1, x2, x3, x4, err := fn(t1.Val(), t2.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), nil
+}
+
+func MonadView24Err[R1, R2, R3, R4, T1, T2 any](
+	fn func(t1 T1, t2 T2) (r1 R1, r2 R2, r3 R3, r4 R4, err error),
+) func(t1 View[T1], t2 View[T2]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+	return func(t1 View[T1], t2 View[T2]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+		return ApplyView24Err(t1, t2, fn)
+	}
+}
+
+func ApplyView24CtxErr[R1, R2, R3, R4, T1, T2 any](
+	ctx context.Context, t1 View[T1], t2 View[T2],
+	fn func(ctx context.Context, t1 T1, t2 T2) (r1 R1, r2 R2, r3 R3, r4 R4, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+	x1, x2, x3, x4, err := fn(ctx, t1.Val(), t2.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), nil
+}
+
+func MonadView24CtxErr[R1, R2, R3, R4, T1, T2 any](
+	fn func(ctx context.Context, t1 T1, t2 T2) (r1 R1, r2 R2, r3 R3, r4 R4, err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+		return ApplyView24CtxErr(ctx, t1, t2, fn)
+	}
+}
+
+func ApplyView25[R1, R2, R3, R4, R5, T1, T2 any](
+	t1 View[T1], t2 View[T2],
+	fn func(t1 T1, t2 T2) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+	x1, x2, x3, x4, x5 := fn(t1.Val(), t2.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), Of(x5).View()
+}
+
+func MonadView25[R1, R2, R3, R4, R5, T1, T2 any](
+	fn func(t1 T1, t2 T2) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5),
+) func(t1 View[T1], t2 View[T2]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+	return func(t1 View[T1], t2 View[T2]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+		return ApplyView25(t1, t2, fn)
+	}
+}
+
+func ApplyView25Ctx[R1, R2, R3, R4, R5, T1, T2 any](
+	ctx context.Context, t1 View[T1], t2 View[T2],
+	fn func(ctx context.Context, t1 T1, t2 T2) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+	x1, x2, x3, x4, x5 := fn(ctx, t1.Val(), t2.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), Of(x5).View()
+}
+
+func MonadView25Ctx[R1, R2, R3, R4, R5, T1, T2 any](
+	fn func(ctx context.Context, t1 T1, t2 T2) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5),
+) func(ctx context.Context, t1 View[T1], t2 View[T2]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+		return ApplyView25Ctx(ctx, t1, t2, fn)
+	}
+}
+
+func ApplyView25Err[R1, R2, R3, R4, R5, T1, T2 any](
+	t1 View[T1], t2 View[T2],
+	fn func(t1 T1, t2 T2) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+	x1, x2, x3, x4, x5, err := fn(t1.Val(), t2.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), Of(x5).View(), nil
+}
+
+func MonadView25Err[R1, R2, R3, R4, R5, T1, T2 any](
+	fn func(t1 T1, t2 T2) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error),
+) func(t1 View[T1], t2 View[T2]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+	return func(t1 View[T1], t2 View[T2]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+		return ApplyView25Err(t1, t2, fn)
+	}
+}
+
+func ApplyView25CtxErr[R1, R2, R3, R4, R5, T1, T2 any](
+	ctx context.Context, t1 View[T1], t2 View[T2],
+	fn func(ctx context.Context, t1 T1, t2 T2) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+	x1, x2, x3, x4, x5, err := fn(ctx, t1.Val(), t2.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), Of(x5).View(), nil
+}
+
+func MonadView25CtxErr[R1, R2, R3, R4, R5, T1, T2 any](
+	fn func(ctx context.Context, t1 T1, t2 T2) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+		return ApplyView25CtxErr(ctx, t1, t2, fn)
+	}
+}
+
+func ApplyView3Void[T1, T2, T3 any](
+	t1 View[T1], t2 View[T2], t3 View[T3],
+	fn func(t1 T1, t2 T2, t3 T3),
+) {
+	fn(t1.Val(), t2.Val(), t3.Val())
+}
+
+func MonadView3Void[T1, T2, T3 any](
+	fn func(t1 T1, t2 T2, t3 T3),
+) func(t1 View[T1], t2 View[T2], t3 View[T3]) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3]) {
+		ApplyView3Void(t1, t2, t3, fn)
+	}
+}
+
+func ApplyView3VoidCtx[T1, T2, T3 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3),
+) {
+	fn(ctx, t1.Val(), t2.Val(), t3.Val())
+}
+
+func MonadView3VoidCtx[T1, T2, T3 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3]) {
+		ApplyView3VoidCtx(ctx, t1, t2, t3, fn)
+	}
+}
+
+func ApplyView3VoidErr[T1, T2, T3 any](
+	t1 View[T1], t2 View[T2], t3 View[T3],
+	fn func(t1 T1, t2 T2, t3 T3) (err error),
+) (err error) {
+	return fn(t1.Val(), t2.Val(), t3.Val())
+}
+
+func MonadView3VoidErr[T1, T2, T3 any](
+	fn func(t1 T1, t2 T2, t3 T3) (err error),
+) func(t1 View[T1], t2 View[T2], t3 View[T3]) (err error) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3]) (err error) {
+		return ApplyView3VoidErr(t1, t2, t3, fn)
+	}
+}
+
+func ApplyView3VoidCtxErr[T1, T2, T3 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3) (err error),
+) (err error) {
+	return fn(ctx, t1.Val(), t2.Val(), t3.Val())
+}
+
+func MonadView3VoidCtxErr[T1, T2, T3 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3) (err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3]) (err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3]) (err error) {
+		return ApplyView3VoidCtxErr(ctx, t1, t2, t3, fn)
+	}
+}
+
+func ApplyView3[R1, T1, T2, T3 any](
+	t1 View[T1], t2 View[T2], t3 View[T3],
+	fn func(t1 T1, t2 T2, t3 T3) (r1 R1),
+) (r1 View[R1]) {
+	x1 := fn(t1.Val(), t2.Val(), t3.Val())
+
+	return Of(x1).View()
+}
+
+func MonadView3[R1, T1, T2, T3 any](
+	fn func(t1 T1, t2 T2, t3 T3) (r1 R1),
+) func(t1 View[T1], t2 View[T2], t3 View[T3]) (r1 View[R1]) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3]) (r1 View[R1]) {
+		return ApplyView3(t1, t2, t3, fn)
+	}
+}
+
+func ApplyView3Ctx[R1, T1, T2, T3 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3) (r1 R1),
+) (r1 View[R1]) {
+	x1 := fn(ctx, t1.Val(), t2.Val(), t3.Val())
+
+	return Of(x1).View()
+}
+
+func MonadView3Ctx[R1, T1, T2, T3 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3) (r1 R1),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3]) (r1 View[R1]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3]) (r1 View[R1]) {
+		return ApplyView3Ctx(ctx, t1, t2, t3, fn)
+	}
+}
+
+func ApplyView3Err[R1, T1, T2, T3 any](
+	t1 View[T1], t2 View[T2], t3 View[T3],
+	fn func(t1 T1, t2 T2, t3 T3) (r1 R1, err error),
+) (r1 View[R1], err error) {
+	x1, err := fn(t1.Val(), t2.Val(), t3.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), nil
+}
+
+func MonadView3Err[R1, T1, T2, T3 any](
+	fn func(t1 T1, t2 T2, t3 T3) (r1 R1, err error),
+) func(t1 View[T1], t2 View[T2], t3 View[T3]) (r1 View[R1], err error) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3]) (r1 View[R1], err error) {
+		return ApplyView3Err(t1, t2, t3, fn)
+	}
+}
+
+func ApplyView3CtxErr[R1, T1, T2, T3 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3) (r1 R1, err error),
+) (r1 View[R1], err error) {
+	x1, err := fn(ctx, t1.Val(), t2.Val(), t3.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), nil
+}
+
+func MonadView3CtxErr[R1, T1, T2, T3 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3) (r1 R1, err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3]) (r1 View[R1], err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3]) (r1 View[R1], err error) {
+		return ApplyView3CtxErr(ctx, t1, t2, t3, fn)
+	}
+}
+
+func ApplyView32[R1, R2, T1, T2, T3 any](
+	t1 View[T1], t2 View[T2], t3 View[T3],
+	fn func(t1 T1, t2 T2, t3 T3) (r1 R1, r2 R2),
+) (r1 View[R1], r2 View[R2]) {
+	x1, x2 := fn(t1.Val(), t2.Val(), t3.Val())
+
+	return Of(x1).View(), Of(x2).View()
+}
+
+func MonadView32[R1, R2, T1, T2, T3 any](
+	fn func(t1 T1, t2 T2, t3 T3) (r1 R1, r2 R2),
+) func(t1 View[T1], t2 View[T2], t3 View[T3]) (r1 View[R1], r2 View[R2]) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3]) (r1 View[R1], r2 View[R2]) {
+		return ApplyView32(t1, t2, t3, fn)
+	}
+}
+
+func ApplyView32Ctx[R1, R2, T1, T2, T3 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3) (r1 R1, r2 R2),
+) (r1 View[R1], r2 View[R2]) {
+	x1, x2 := fn(ctx, t1.Val(), t2.Val(), t3.Val())
+
+	return Of(x1).View(), Of(x2).View()
+}
+
+func MonadView32Ctx[R1, R2, T1, T2, T3 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3) (r1 R1, r2 R2),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3]) (r1 View[R1], r2 View[R2]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3]) (r1 View[R1], r2 View[R2]) {
+		return ApplyView32Ctx(ctx, t1, t2, t3, fn)
+	}
+}
+
+func ApplyView32Err[R1, R2, T1, T2, T3 any](
+	t1 View[T1], t2 View[T2], t3 View[T3],
+	fn func(t1 T1, t2 T2, t3 T3) (r1 R1, r2 R2, err error),
+) (r1 View[R1], r2 View[R2], err error) {
+	x1, x2, err := fn(t1.Val(), t2.Val(), t3.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), nil
+}
+
+func MonadView32Err[R1, R2, T1, T2, T3 any](
+	fn func(t1 T1, t2 T2, t3 T3) (r1 R1, r2 R2, err error),
+) func(t1 View[T1], t2 View[T2], t3 View[T3]) (r1 View[R1], r2 View[R2], err error) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3]) (r1 View[R1], r2 View[R2], err error) {
+		return ApplyView32Err(t1, t2, t3, fn)
+	}
+}
+
+func ApplyView32CtxErr[R1, R2, T1, T2, T3 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3) (r1 R1, r2 R2, err error),
+) (r1 View[R1], r2 View[R2], err error) {
+	x1, x2, err := fn(ctx, t1.Val(), t2.Val(), t3.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), nil
+}
+
+func MonadView32CtxErr[R1, R2, T1, T2, T3 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3) (r1 R1, r2 R2, err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3]) (r1 View[R1], r2 View[R2], err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3]) (r1 View[R1], r2 View[R2], err error) {
+		return ApplyView32CtxErr(ctx, t1, t2, t3, fn)
+	}
+}
+
+func ApplyView33[R1, R2, R3, T1, T2, T3 any](
+	t1 View[T1], t2 View[T2], t3 View[T3],
+	fn func(t1 T1, t2 T2, t3 T3) (r1 R1, r2 R2, r3 R3),
+) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+	x1, x2, x3 := fn(t1.Val(), t2.Val(), t3.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View()
+}
+
+func MonadView33[R1, R2, R3, T1, T2, T3 any](
+	fn func(t1 T1, t2 T2, t3 T3) (r1 R1, r2 R2, r3 R3),
+) func(t1 View[T1], t2 View[T2], t3 View[T3]) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3]) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+		return ApplyView33(t1, t2, t3, fn)
+	}
+}
+
+func ApplyView33Ctx[R1, R2, R3, T1, T2, T3 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3) (r1 R1, r2 R2, r3 R3),
+) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+	x1, x2, x3 := fn(ctx, t1.Val(), t2.Val(), t3.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View()
+}
+
+func MonadView33Ctx[R1, R2, R3, T1, T2, T3 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3) (r1 R1, r2 R2, r3 R3),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3]) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3]) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+		return ApplyView33Ctx(ctx, t1, t2, t3, fn)
+	}
+}
+
+func ApplyView33Err[R1, R2, R3, T1, T2, T3 any](
+	t1 View[T1], t2 View[T2], t3 View[T3],
+	fn func(t1 T1, t2 T2, t3 T3) (r1 R1, r2 R2, r3 R3, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+	x1, x2, x3, err := fn(t1.Val(), t2.Val(), t3.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), nil
+}
+
+func MonadView33Err[R1, R2, R3, T1, T2, T3 any](
+	fn func(t1 T1, t2 T2, t3 T3) (r1 R1, r2 R2, r3 R3, err error),
+) func(t1 View[T1], t2 View[T2], t3 View[T3]) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3]) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+		return ApplyView33Err(t1, t2, t3, fn)
+	}
+}
+
+func ApplyView33CtxErr[R1, R2, R3, T1, T2, T3 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3) (r1 R1, r2 R2, r3 R3, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+	x1, x2, x3, err := fn(ctx, t1.Val(), t2.Val(), t3.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), nil
+}
+
+func MonadView33CtxErr[R1, R2, R3, T1, T2, T3 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3) (r1 R1, r2 R2, r3 R3, err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3]) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3]) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+		return ApplyView33CtxErr(ctx, t1, t2, t3, fn)
+	}
+}
+
+func ApplyView34[R1, R2, R3, R4, T1, T2, T3 any](
+	t1 View[T1], t2 View[T2], t3 View[T3],
+	fn func(t1 T1, t2 T2, t3 T3) (r1 R1, r2 R2, r3 R3, r4 R4),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+	x1, x2, x3, x4 := fn(t1.Val(), t2.Val(), t3.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View()
+}
+
+func MonadView34[R1, R2, R3, R4, T1, T2, T3 any](
+	fn func(t1 T1, t2 T2, t3 T3) (r1 R1, r2 R2, r3 R3, r4 R4),
+) func(t1 View[T1], t2 View[T2], t3 View[T3]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+		return ApplyView34(t1, t2, t3, fn)
+	}
+}
+
+func ApplyView34Ctx[R1, R2, R3, R4, T1, T2, T3 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3) (r1 R1, r2 R2, r3 R3, r4 R4),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+	x1, x2, x3, x4 := fn(ctx, t1.Val(), t2.Val(), t3.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View()
+}
+
+func MonadView34Ctx[R1, R2, R3, R4, T1, T2, T3 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3) (r1 R1, r2 R2, r3 R3, r4 R4),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+		return ApplyView34Ctx(ctx, t1, t2, t3, fn)
+	}
+}
+
+func ApplyView34Err[R1, R2, R3, R4, T1, T2, T3 any](
+	t1 View[T1], t2 View[T2], t3 View[T3],
+	fn func(t1 T1, t2 T2, t3 T3) (r1 R1, r2 R2, r3 R3, r4 R4, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+	x1, x2, x3, x4, err := fn(t1.Val(), t2.Val(), t3.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), nil
+}
+
+func MonadView34Err[R1, R2, R3, R4, T1, T2, T3 any](
+	fn func(t1 T1, t2 T2, t3 T3) (r1 R1, r2 R2, r3 R3, r4 R4, err error),
+) func(t1 View[T1], t2 View[T2], t3 View[T3]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+		return ApplyView34Err(t1, t2, t3, fn)
+	}
+}
+
+func ApplyView34CtxErr[R1, R2, R3, R4, T1, T2, T3 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3) (r1 R1, r2 R2, r3 R3, r4 R4, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+	x1, x2, x3, x4, err := fn(ctx, t1.Val(), t2.Val(), t3.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), nil
+}
+
+func MonadView34CtxErr[R1, R2, R3, R4, T1, T2, T3 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3) (r1 R1, r2 R2, r3 R3, r4 R4, err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+		return ApplyView34CtxErr(ctx, t1, t2, t3, fn)
+	}
+}
+
+func ApplyView35[R1, R2, R3, R4, R5, T1, T2, T3 any](
+	t1 View[T1], t2 View[T2], t3 View[T3],
+	fn func(t1 T1, t2 T2, t3 T3) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+	x1, x2, x3, x4, x5 := fn(t1.Val(), t2.Val(), t3.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), Of(x5).View()
+}
+
+func MonadView35[R1, R2, R3, R4, R5, T1, T2, T3 any](
+	fn func(t1 T1, t2 T2, t3 T3) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5),
+) func(t1 View[T1], t2 View[T2], t3 View[T3]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+		return ApplyView35(t1, t2, t3, fn)
+	}
+}
+
+func ApplyView35Ctx[R1, R2, R3, R4, R5, T1, T2, T3 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+	x1, x2, x3, x4, x5 := fn(ctx, t1.Val(), t2.Val(), t3.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), Of(x5).View()
+}
+
+func MonadView35Ctx[R1, R2, R3, R4, R5, T1, T2, T3 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+		return ApplyView35Ctx(ctx, t1, t2, t3, fn)
+	}
+}
+
+func ApplyView35Err[R1, R2, R3, R4, R5, T1, T2, T3 any](
+	t1 View[T1], t2 View[T2], t3 View[T3],
+	fn func(t1 T1, t2 T2, t3 T3) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+	x1, x2, x3, x4, x5, err := fn(t1.Val(), t2.Val(), t3.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), Of(x5).View(), nil
+}
+
+func MonadView35Err[R1, R2, R3, R4, R5, T1, T2, T3 any](
+	fn func(t1 T1, t2 T2, t3 T3) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error),
+) func(t1 View[T1], t2 View[T2], t3 View[T3]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+		return ApplyView35Err(t1, t2, t3, fn)
+	}
+}
+
+func ApplyView35CtxErr[R1, R2, R3, R4, R5, T1, T2, T3 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+	x1, x2, x3, x4, x5, err := fn(ctx, t1.Val(), t2.Val(), t3.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), Of(x5).View(), nil
+}
+
+func MonadView35CtxErr[R1, R2, R3, R4, R5, T1, T2, T3 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+		return ApplyView35CtxErr(ctx, t1, t2, t3, fn)
+	}
+}
+
+func ApplyView4Void[T1, T2, T3, T4 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4),
+) {
+	fn(t1.Val(), t2.Val(), t3.Val(), t4.Val())
+}
+
+func MonadView4Void[T1, T2, T3, T4 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) {
+		ApplyView4Void(t1, t2, t3, t4, fn)
+	}
+}
+
+func ApplyView4VoidCtx[T1, T2, T3, T4 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4),
+) {
+	fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val())
+}
+
+func MonadView4VoidCtx[T1, T2, T3, T4 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) {
+		ApplyView4VoidCtx(ctx, t1, t2, t3, t4, fn)
+	}
+}
+
+func ApplyView4VoidErr[T1, T2, T3, T4 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4) (err error),
+) (err error) {
+	return fn(t1.Val(), t2.Val(), t3.Val(), t4.Val())
+}
+
+func MonadView4VoidErr[T1, T2, T3, T4 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4) (err error),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (err error) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (err error) {
+		return ApplyView4VoidErr(t1, t2, t3, t4, fn)
+	}
+}
+
+func ApplyView4VoidCtxErr[T1, T2, T3, T4 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4) (err error),
+) (err error) {
+	return fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val())
+}
+
+func MonadView4VoidCtxErr[T1, T2, T3, T4 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4) (err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (err error) {
+		return ApplyView4VoidCtxErr(ctx, t1, t2, t3, t4, fn)
+	}
+}
+
+func ApplyView4[R1, T1, T2, T3, T4 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4) (r1 R1),
+) (r1 View[R1]) {
+	x1 := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val())
+
+	return Of(x1).View()
+}
+
+func MonadView4[R1, T1, T2, T3, T4 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4) (r1 R1),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (r1 View[R1]) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (r1 View[R1]) {
+		return ApplyView4(t1, t2, t3, t4, fn)
+	}
+}
+
+func ApplyView4Ctx[R1, T1, T2, T3, T4 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4) (r1 R1),
+) (r1 View[R1]) {
+	x1 := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val())
+
+	return Of(x1).View()
+}
+
+func MonadView4Ctx[R1, T1, T2, T3, T4 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4) (r1 R1),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (r1 View[R1]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (r1 View[R1]) {
+		return ApplyView4Ctx(ctx, t1, t2, t3, t4, fn)
+	}
+}
+
+func ApplyView4Err[R1, T1, T2, T3, T4 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4) (r1 R1, err error),
+) (r1 View[R1], err error) {
+	x1, err := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), nil
+}
+
+func MonadView4Err[R1, T1, T2, T3, T4 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4) (r1 R1, err error),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (r1 View[R1], err error) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (r1 View[R1], err error) {
+		return ApplyView4Err(t1, t2, t3, t4, fn)
+	}
+}
+
+func ApplyView4CtxErr[R1, T1, T2, T3, T4 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4) (r1 R1, err error),
+) (r1 View[R1], err error) {
+	x1, err := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), nil
+}
+
+func MonadView4CtxErr[R1, T1, T2, T3, T4 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4) (r1 R1, err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (r1 View[R1], err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (r1 View[R1], err error) {
+		return ApplyView4CtxErr(ctx, t1, t2, t3, t4, fn)
+	}
+}
+
+func ApplyView42[R1, R2, T1, T2, T3, T4 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4) (r1 R1, r2 R2),
+) (r1 View[R1], r2 View[R2]) {
+	x1, x2 := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val())
+
+	return Of(x1).View(), Of(x2).View()
+}
+
+func MonadView42[R1, R2, T1, T2, T3, T4 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4) (r1 R1, r2 R2),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (r1 View[R1], r2 View[R2]) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (r1 View[R1], r2 View[R2]) {
+		return ApplyView42(t1, t2, t3, t4, fn)
+	}
+}
+
+func ApplyView42Ctx[R1, R2, T1, T2, T3, T4 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4) (r1 R1, r2 R2),
+) (r1 View[R1], r2 View[R2]) {
+	x1, x2 := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val())
+
+	return Of(x1).View(), Of(x2).View()
+}
+
+func MonadView42Ctx[R1, R2, T1, T2, T3, T4 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4) (r1 R1, r2 R2),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (r1 View[R1], r2 View[R2]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (r1 View[R1], r2 View[R2]) {
+		return ApplyView42Ctx(ctx, t1, t2, t3, t4, fn)
+	}
+}
+
+func ApplyView42Err[R1, R2, T1, T2, T3, T4 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4) (r1 R1, r2 R2, err error),
+) (r1 View[R1], r2 View[R2], err error) {
+	x1, x2, err := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), nil
+}
+
+func MonadView42Err[R1, R2, T1, T2, T3, T4 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4) (r1 R1, r2 R2, err error),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (r1 View[R1], r2 View[R2], err error) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (r1 View[R1], r2 View[R2], err error) {
+		return ApplyView42Err(t1, t2, t3, t4, fn)
+	}
+}
+
+func ApplyView42CtxErr[R1, R2, T1, T2, T3, T4 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4) (r1 R1, r2 R2, err error),
+) (r1 View[R1], r2 View[R2], err error) {
+	x1, x2, err := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), nil
+}
+
+func MonadView42CtxErr[R1, R2, T1, T2, T3, T4 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4) (r1 R1, r2 R2, err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (r1 View[R1], r2 View[R2], err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (r1 View[R1], r2 View[R2], err error) {
+		return ApplyView42CtxErr(ctx, t1, t2, t3, t4, fn)
+	}
+}
+
+func ApplyView43[R1, R2, R3, T1, T2, T3, T4 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4) (r1 R1, r2 R2, r3 R3),
+) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+	x1, x2, x3 := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View()
+}
+
+func MonadView43[R1, R2, R3, T1, T2, T3, T4 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4) (r1 R1, r2 R2, r3 R3),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+		return ApplyView43(t1, t2, t3, t4, fn)
+	}
+}
+
+func ApplyView43Ctx[R1, R2, R3, T1, T2, T3, T4 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4) (r1 R1, r2 R2, r3 R3),
+) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+	x1, x2, x3 := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View()
+}
+
+func MonadView43Ctx[R1, R2, R3, T1, T2, T3, T4 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4) (r1 R1, r2 R2, r3 R3),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+		return ApplyView43Ctx(ctx, t1, t2, t3, t4, fn)
+	}
+}
+
+func ApplyView43Err[R1, R2, R3, T1, T2, T3, T4 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4) (r1 R1, r2 R2, r3 R3, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+	x1, x2, x3, err := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), nil
+}
+
+func MonadView43Err[R1, R2, R3, T1, T2, T3, T4 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4) (r1 R1, r2 R2, r3 R3, err error),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+		return ApplyView43Err(t1, t2, t3, t4, fn)
+	}
+}
+
+func ApplyView43CtxErr[R1, R2, R3, T1, T2, T3, T4 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4) (r1 R1, r2 R2, r3 R3, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+	x1, x2, x3, err := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), nil
+}
+
+func MonadView43CtxErr[R1, R2, R3, T1, T2, T3, T4 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4) (r1 R1, r2 R2, r3 R3, err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+		return ApplyView43CtxErr(ctx, t1, t2, t3, t4, fn)
+	}
+}
+
+func ApplyView44[R1, R2, R3, R4, T1, T2, T3, T4 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4) (r1 R1, r2 R2, r3 R3, r4 R4),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+	x1, x2, x3, x4 := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View()
+}
+
+func MonadView44[R1, R2, R3, R4, T1, T2, T3, T4 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4) (r1 R1, r2 R2, r3 R3, r4 R4),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+		return ApplyView44(t1, t2, t3, t4, fn)
+	}
+}
+
+func ApplyView44Ctx[R1, R2, R3, R4, T1, T2, T3, T4 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4) (r1 R1, r2 R2, r3 R3, r4 R4),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+	x1, x2, x3, x4 := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View()
+}
+
+func MonadView44Ctx[R1, R2, R3, R4, T1, T2, T3, T4 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4) (r1 R1, r2 R2, r3 R3, r4 R4),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+		return ApplyView44Ctx(ctx, t1, t2, t3, t4, fn)
+	}
+}
+
+func ApplyView44Err[R1, R2, R3, R4, T1, T2, T3, T4 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4) (r1 R1, r2 R2, r3 R3, r4 R4, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+	x1, x2, x3, x4, err := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), nil
+}
+
+func MonadView44Err[R1, R2, R3, R4, T1, T2, T3, T4 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4) (r1 R1, r2 R2, r3 R3, r4 R4, err error),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+		return ApplyView44Err(t1, t2, t3, t4, fn)
+	}
+}
+
+func ApplyView44CtxErr[R1, R2, R3, R4, T1, T2, T3, T4 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4) (r1 R1, r2 R2, r3 R3, r4 R4, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+	x1, x2, x3, x4, err := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), nil
+}
+
+func MonadView44CtxErr[R1, R2, R3, R4, T1, T2, T3, T4 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4) (r1 R1, r2 R2, r3 R3, r4 R4, err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+		return ApplyView44CtxErr(ctx, t1, t2, t3, t4, fn)
+	}
+}
+
+func ApplyView45[R1, R2, R3, R4, R5, T1, T2, T3, T4 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+	x1, x2, x3, x4, x5 := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), Of(x5).View()
+}
+
+func MonadView45[R1, R2, R3, R4, R5, T1, T2, T3, T4 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+		return ApplyView45(t1, t2, t3, t4, fn)
+	}
+}
+
+func ApplyView45Ctx[R1, R2, R3, R4, R5, T1, T2, T3, T4 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+	x1, x2, x3, x4, x5 := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), Of(x5).View()
+}
+
+func MonadView45Ctx[R1, R2, R3, R4, R5, T1, T2, T3, T4 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+		return ApplyView45Ctx(ctx, t1, t2, t3, t4, fn)
+	}
+}
+
+func ApplyView45Err[R1, R2, R3, R4, R5, T1, T2, T3, T4 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+	x1, x2, x3, x4, x5, err := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), Of(x5).View(), nil
+}
+
+func MonadView45Err[R1, R2, R3, R4, R5, T1, T2, T3, T4 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+		return ApplyView45Err(t1, t2, t3, t4, fn)
+	}
+}
+
+func ApplyView45CtxErr[R1, R2, R3, R4, R5, T1, T2, T3, T4 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+	x1, x2, x3, x4, x5, err := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), Of(x5).View(), nil
+}
+
+func MonadView45CtxErr[R1, R2, R3, R4, R5, T1, T2, T3, T4 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+		return ApplyView45CtxErr(ctx, t1, t2, t3, t4, fn)
+	}
+}
+
+func ApplyView5Void[T1, T2, T3, T4, T5 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5),
+) {
+	fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val())
+}
+
+func MonadView5Void[T1, T2, T3, T4, T5 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) {
+		ApplyView5Void(t1, t2, t3, t4, t5, fn)
+	}
+}
+
+func ApplyView5VoidCtx[T1, T2, T3, T4, T5 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5),
+) {
+	fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val())
+}
+
+func MonadView5VoidCtx[T1, T2, T3, T4, T5 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) {
+		ApplyView5VoidCtx(ctx, t1, t2, t3, t4, t5, fn)
+	}
+}
+
+func ApplyView5VoidErr[T1, T2, T3, T4, T5 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (err error),
+) (err error) {
+	return fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val())
+}
+
+func MonadView5VoidErr[T1, T2, T3, T4, T5 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (err error),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (err error) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (err error) {
+		return ApplyView5VoidErr(t1, t2, t3, t4, t5, fn)
+	}
+}
+
+func ApplyView5VoidCtxErr[T1, T2, T3, T4, T5 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (err error),
+) (err error) {
+	return fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val())
+}
+
+func MonadView5VoidCtxErr[T1, T2, T3, T4, T5 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (err error) {
+		return ApplyView5VoidCtxErr(ctx, t1, t2, t3, t4, t5, fn)
+	}
+}
+
+func ApplyView5[R1, T1, T2, T3, T4, T5 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (r1 R1),
+) (r1 View[R1]) {
+	x1 := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val())
+
+	return Of(x1).View()
+}
+
+func MonadView5[R1, T1, T2, T3, T4, T5 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (r1 R1),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (r1 View[R1]) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (r1 View[R1]) {
+		return ApplyView5(t1, t2, t3, t4, t5, fn)
+	}
+}
+
+func ApplyView5Ctx[R1, T1, T2, T3, T4, T5 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (r1 R1),
+) (r1 View[R1]) {
+	x1 := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val())
+
+	return Of(x1).View()
+}
+
+func MonadView5Ctx[R1, T1, T2, T3, T4, T5 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (r1 R1),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (r1 View[R1]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (r1 View[R1]) {
+		return ApplyView5Ctx(ctx, t1, t2, t3, t4, t5, fn)
+	}
+}
+
+func ApplyView5Err[R1, T1, T2, T3, T4, T5 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (r1 R1, err error),
+) (r1 View[R1], err error) {
+	x1, err := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), nil
+}
+
+func MonadView5Err[R1, T1, T2, T3, T4, T5 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (r1 R1, err error),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (r1 View[R1], err error) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (r1 View[R1], err error) {
+		return ApplyView5Err(t1, t2, t3, t4, t5, fn)
+	}
+}
+
+func ApplyView5CtxErr[R1, T1, T2, T3, T4, T5 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (r1 R1, err error),
+) (r1 View[R1], err error) {
+	x1, err := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), nil
+}
+
+func MonadView5CtxErr[R1, T1, T2, T3, T4, T5 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (r1 R1, err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (r1 View[R1], err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (r1 View[R1], err error) {
+		return ApplyView5CtxErr(ctx, t1, t2, t3, t4, t5, fn)
+	}
+}
+
+func ApplyView52[R1, R2, T1, T2, T3, T4, T5 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (r1 R1, r2 R2),
+) (r1 View[R1], r2 View[R2]) {
+	x1, x2 := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val())
+
+	return Of(x1).View(), Of(x2).View()
+}
+
+func MonadView52[R1, R2, T1, T2, T3, T4, T5 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (r1 R1, r2 R2),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (r1 View[R1], r2 View[R2]) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (r1 View[R1], r2 View[R2]) {
+		return ApplyView52(t1, t2, t3, t4, t5, fn)
+	}
+}
+
+func ApplyView52Ctx[R1, R2, T1, T2, T3, T4, T5 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (r1 R1, r2 R2),
+) (r1 View[R1], r2 View[R2]) {
+	x1, x2 := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val())
+
+	return Of(x1).View(), Of(x2).View()
+}
+
+func MonadView52Ctx[R1, R2, T1, T2, T3, T4, T5 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (r1 R1, r2 R2),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (r1 View[R1], r2 View[R2]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (r1 View[R1], r2 View[R2]) {
+		return ApplyView52Ctx(ctx, t1, t2, t3, t4, t5, fn)
+	}
+}
+
+func ApplyView52Err[R1, R2, T1, T2, T3, T4, T5 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (r1 R1, r2 R2, err error),
+) (r1 View[R1], r2 View[R2], err error) {
+	x1, x2, err := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), nil
+}
+
+func MonadView52Err[R1, R2, T1, T2, T3, T4, T5 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (r1 R1, r2 R2, err error),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (r1 View[R1], r2 View[R2], err error) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (r1 View[R1], r2 View[R2], err error) {
+		return ApplyView52Err(t1, t2, t3, t4, t5, fn)
+	}
+}
+
+func ApplyView52CtxErr[R1, R2, T1, T2, T3, T4, T5 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (r1 R1, r2 R2, err error),
+) (r1 View[R1], r2 View[R2], err error) {
+	x1, x2, err := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), nil
+}
+
+func MonadView52CtxErr[R1, R2, T1, T2, T3, T4, T5 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (r1 R1, r2 R2, err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (r1 View[R1], r2 View[R2], err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (r1 View[R1], r2 View[R2], err error) {
+		return ApplyView52CtxErr(ctx, t1, t2, t3, t4, t5, fn)
+	}
+}
+
+func ApplyView53[R1, R2, R3, T1, T2, T3, T4, T5 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (r1 R1, r2 R2, r3 R3),
+) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+	x1, x2, x3 := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View()
+}
+
+func MonadView53[R1, R2, R3, T1, T2, T3, T4, T5 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (r1 R1, r2 R2, r3 R3),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+		return ApplyView53(t1, t2, t3, t4, t5, fn)
+	}
+}
+
+func ApplyView53Ctx[R1, R2, R3, T1, T2, T3, T4, T5 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (r1 R1, r2 R2, r3 R3),
+) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+	x1, x2, x3 := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View()
+}
+
+func MonadView53Ctx[R1, R2, R3, T1, T2, T3, T4, T5 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (r1 R1, r2 R2, r3 R3),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+		return ApplyView53Ctx(ctx, t1, t2, t3, t4, t5, fn)
+	}
+}
+
+func ApplyView53Err[R1, R2, R3, T1, T2, T3, T4, T5 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (r1 R1, r2 R2, r3 R3, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+	x1, x2, x3, err := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), nil
+}
+
+func MonadView53Err[R1, R2, R3, T1, T2, T3, T4, T5 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (r1 R1, r2 R2, r3 R3, err error),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+		return ApplyView53Err(t1, t2, t3, t4, t5, fn)
+	}
+}
+
+func ApplyView53CtxErr[R1, R2, R3, T1, T2, T3, T4, T5 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (r1 R1, r2 R2, r3 R3, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+	x1, x2, x3, err := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), nil
+}
+
+func MonadView53CtxErr[R1, R2, R3, T1, T2, T3, T4, T5 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (r1 R1, r2 R2, r3 R3, err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+		return ApplyView53CtxErr(ctx, t1, t2, t3, t4, t5, fn)
+	}
+}
+
+func ApplyView54[R1, R2, R3, R4, T1, T2, T3, T4, T5 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (r1 R1, r2 R2, r3 R3, r4 R4),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+	x1, x2, x3, x4 := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View()
+}
+
+func MonadView54[R1, R2, R3, R4, T1, T2, T3, T4, T5 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (r1 R1, r2 R2, r3 R3, r4 R4),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+		return ApplyView54(t1, t2, t3, t4, t5, fn)
+	}
+}
+
+func ApplyView54Ctx[R1, R2, R3, R4, T1, T2, T3, T4, T5 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (r1 R1, r2 R2, r3 R3, r4 R4),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+	x1, x2, x3, x4 := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View()
+}
+
+func MonadView54Ctx[R1, R2, R3, R4, T1, T2, T3, T4, T5 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (r1 R1, r2 R2, r3 R3, r4 R4),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+		return ApplyView54Ctx(ctx, t1, t2, t3, t4, t5, fn)
+	}
+}
+
+func ApplyView54Err[R1, R2, R3, R4, T1, T2, T3, T4, T5 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (r1 R1, r2 R2, r3 R3, r4 R4, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+	x1, x2, x3, x4, err := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), nil
+}
+
+func MonadView54Err[R1, R2, R3, R4, T1, T2, T3, T4, T5 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (r1 R1, r2 R2, r3 R3, r4 R4, err error),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+		return ApplyView54Err(t1, t2, t3, t4, t5, fn)
+	}
+}
+
+func ApplyView54CtxErr[R1, R2, R3, R4, T1, T2, T3, T4, T5 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (r1 R1, r2 R2, r3 R3, r4 R4, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+	x1, x2, x3, x4, err := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), nil
+}
+
+func MonadView54CtxErr[R1, R2, R3, R4, T1, T2, T3, T4, T5 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (r1 R1, r2 R2, r3 R3, r4 R4, err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+		return ApplyView54CtxErr(ctx, t1, t2, t3, t4, t5, fn)
+	}
+}
+
+func ApplyView55[R1, R2, R3, R4, R5, T1, T2, T3, T4, T5 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+	x1, x2, x3, x4, x5 := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), Of(x5).View()
+}
+
+func MonadView55[R1, R2, R3, R4, R5, T1, T2, T3, T4, T5 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+		return ApplyView55(t1, t2, t3, t4, t5, fn)
+	}
+}
+
+func ApplyView55Ctx[R1, R2, R3, R4, R5, T1, T2, T3, T4, T5 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+	x1, x2, x3, x4, x5 := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), Of(x5).View()
+}
+
+func MonadView55Ctx[R1, R2, R3, R4, R5, T1, T2, T3, T4, T5 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+		return ApplyView55Ctx(ctx, t1, t2, t3, t4, t5, fn)
+	}
+}
+
+func ApplyView55Err[R1, R2, R3, R4, R5, T1, T2, T3, T4, T5 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+	x1, x2, x3, x4, x5, err := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), Of(x5).View(), nil
+}
+
+func MonadView55Err[R1, R2, R3, R4, R5, T1, T2, T3, T4, T5 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+		return ApplyView55Err(t1, t2, t3, t4, t5, fn)
+	}
+}
+
+func ApplyView55CtxErr[R1, R2, R3, R4, R5, T1, T2, T3, T4, T5 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+	x1, x2, x3, x4, x5, err := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), Of(x5).View(), nil
+}
+
+func MonadView55CtxErr[R1, R2, R3, R4, R5, T1, T2, T3, T4, T5 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+		return ApplyView55CtxErr(ctx, t1, t2, t3, t4, t5, fn)
+	}
+}
+
+func ApplyView6Void[T1, T2, T3, T4, T5, T6 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6),
+) {
+	fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val())
+}
+
+func MonadView6Void[T1, T2, T3, T4, T5, T6 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) {
+		ApplyView6Void(t1, t2, t3, t4, t5, t6, fn)
+	}
+}
+
+func ApplyView6VoidCtx[T1, T2, T3, T4, T5, T6 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6),
+) {
+	fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val())
+}
+
+func MonadView6VoidCtx[T1, T2, T3, T4, T5, T6 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) {
+		ApplyView6VoidCtx(ctx, t1, t2, t3, t4, t5, t6, fn)
+	}
+}
+
+func ApplyView6VoidErr[T1, T2, T3, T4, T5, T6 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (err error),
+) (err error) {
+	return fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val())
+}
+
+func MonadView6VoidErr[T1, T2, T3, T4, T5, T6 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (err error),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (err error) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (err error) {
+		return ApplyView6VoidErr(t1, t2, t3, t4, t5, t6, fn)
+	}
+}
+
+func ApplyView6VoidCtxErr[T1, T2, T3, T4, T5, T6 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (err error),
+) (err error) {
+	return fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val())
+}
+
+func MonadView6VoidCtxErr[T1, T2, T3, T4, T5, T6 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (err error) {
+		return ApplyView6VoidCtxErr(ctx, t1, t2, t3, t4, t5, t6, fn)
+	}
+}
+
+func ApplyView6[R1, T1, T2, T3, T4, T5, T6 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (r1 R1),
+) (r1 View[R1]) {
+	x1 := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val())
+
+	return Of(x1).View()
+}
+
+func MonadView6[R1, T1, T2, T3, T4, T5, T6 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (r1 R1),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (r1 View[R1]) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (r1 View[R1]) {
+		return ApplyView6(t1, t2, t3, t4, t5, t6, fn)
+	}
+}
+
+func ApplyView6Ctx[R1, T1, T2, T3, T4, T5, T6 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (r1 R1),
+) (r1 View[R1]) {
+	x1 := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val())
+
+	return Of(x1).View()
+}
+
+func MonadView6Ctx[R1, T1, T2, T3, T4, T5, T6 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (r1 R1),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (r1 View[R1]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (r1 View[R1]) {
+		return ApplyView6Ctx(ctx, t1, t2, t3, t4, t5, t6, fn)
+	}
+}
+
+func ApplyView6Err[R1, T1, T2, T3, T4, T5, T6 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (r1 R1, err error),
+) (r1 View[R1], err error) {
+	x1, err := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), nil
+}
+
+func MonadView6Err[R1, T1, T2, T3, T4, T5, T6 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (r1 R1, err error),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (r1 View[R1], err error) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (r1 View[R1], err error) {
+		return ApplyView6Err(t1, t2, t3, t4, t5, t6, fn)
+	}
+}
+
+func ApplyView6CtxErr[R1, T1, T2, T3, T4, T5, T6 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (r1 R1, err error),
+) (r1 View[R1], err error) {
+	x1, err := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), nil
+}
+
+func MonadView6CtxErr[R1, T1, T2, T3, T4, T5, T6 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (r1 R1, err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (r1 View[R1], err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (r1 View[R1], err error) {
+		return ApplyView6CtxErr(ctx, t1, t2, t3, t4, t5, t6, fn)
+	}
+}
+
+func ApplyView62[R1, R2, T1, T2, T3, T4, T5, T6 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (r1 R1, r2 R2),
+) (r1 View[R1], r2 View[R2]) {
+	x1, x2 := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val())
+
+	return Of(x1).View(), Of(x2).View()
+}
+
+func MonadView62[R1, R2, T1, T2, T3, T4, T5, T6 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (r1 R1, r2 R2),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (r1 View[R1], r2 View[R2]) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (r1 View[R1], r2 View[R2]) {
+		return ApplyView62(t1, t2, t3, t4, t5, t6, fn)
+	}
+}
+
+func ApplyView62Ctx[R1, R2, T1, T2, T3, T4, T5, T6 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (r1 R1, r2 R2),
+) (r1 View[R1], r2 View[R2]) {
+	x1, x2 := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val())
+
+	return Of(x1).View(), Of(x2).View()
+}
+
+func MonadView62Ctx[R1, R2, T1, T2, T3, T4, T5, T6 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (r1 R1, r2 R2),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (r1 View[R1], r2 View[R2]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (r1 View[R1], r2 View[R2]) {
+		return ApplyView62Ctx(ctx, t1, t2, t3, t4, t5, t6, fn)
+	}
+}
+
+func ApplyView62Err[R1, R2, T1, T2, T3, T4, T5, T6 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (r1 R1, r2 R2, err error),
+) (r1 View[R1], r2 View[R2], err error) {
+	x1, x2, err := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), nil
+}
+
+func MonadView62Err[R1, R2, T1, T2, T3, T4, T5, T6 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (r1 R1, r2 R2, err error),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (r1 View[R1], r2 View[R2], err error) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (r1 View[R1], r2 View[R2], err error) {
+		return ApplyView62Err(t1, t2, t3, t4, t5, t6, fn)
+	}
+}
+
+func ApplyView62CtxErr[R1, R2, T1, T2, T3, T4, T5, T6 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (r1 R1, r2 R2, err error),
+) (r1 View[R1], r2 View[R2], err error) {
+	x1, x2, err := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), nil
+}
+
+func MonadView62CtxErr[R1, R2, T1, T2, T3, T4, T5, T6 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (r1 R1, r2 R2, err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (r1 View[R1], r2 View[R2], err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (r1 View[R1], r2 View[R2], err error) {
+		return ApplyView62CtxErr(ctx, t1, t2, t3, t4, t5, t6, fn)
+	}
+}
+
+func ApplyView63[R1, R2, R3, T1, T2, T3, T4, T5, T6 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (r1 R1, r2 R2, r3 R3),
+) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+	x1, x2, x3 := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View()
+}
+
+func MonadView63[R1, R2, R3, T1, T2, T3, T4, T5, T6 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (r1 R1, r2 R2, r3 R3),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+		return ApplyView63(t1, t2, t3, t4, t5, t6, fn)
+	}
+}
+
+func ApplyView63Ctx[R1, R2, R3, T1, T2, T3, T4, T5, T6 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (r1 R1, r2 R2, r3 R3),
+) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+	x1, x2, x3 := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View()
+}
+
+func MonadView63Ctx[R1, R2, R3, T1, T2, T3, T4, T5, T6 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (r1 R1, r2 R2, r3 R3),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+		return ApplyView63Ctx(ctx, t1, t2, t3, t4, t5, t6, fn)
+	}
+}
+
+func ApplyView63Err[R1, R2, R3, T1, T2, T3, T4, T5, T6 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (r1 R1, r2 R2, r3 R3, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+	x1, x2, x3, err := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), nil
+}
+
+func MonadView63Err[R1, R2, R3, T1, T2, T3, T4, T5, T6 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (r1 R1, r2 R2, r3 R3, err error),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+		return ApplyView63Err(t1, t2, t3, t4, t5, t6, fn)
+	}
+}
+
+func ApplyView63CtxErr[R1, R2, R3, T1, T2, T3, T4, T5, T6 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (r1 R1, r2 R2, r3 R3, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+	x1, x2, x3, err := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), nil
+}
+
+func MonadView63CtxErr[R1, R2, R3, T1, T2, T3, T4, T5, T6 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (r1 R1, r2 R2, r3 R3, err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+		return ApplyView63CtxErr(ctx, t1, t2, t3, t4, t5, t6, fn)
+	}
+}
+
+func ApplyView64[R1, R2, R3, R4, T1, T2, T3, T4, T5, T6 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (r1 R1, r2 R2, r3 R3, r4 R4),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+	x1, x2, x3, x4 := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View()
+}
+
+func MonadView64[R1, R2, R3, R4, T1, T2, T3, T4, T5, T6 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (r1 R1, r2 R2, r3 R3, r4 R4),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+		return ApplyView64(t1, t2, t3, t4, t5, t6, fn)
+	}
+}
+
+func ApplyView64Ctx[R1, R2, R3, R4, T1, T2, T3, T4, T5, T6 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (r1 R1, r2 R2, r3 R3, r4 R4),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+	x1, x2, x3, x4 := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View()
+}
+
+func MonadView64Ctx[R1, R2, R3, R4, T1, T2, T3, T4, T5, T6 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (r1 R1, r2 R2, r3 R3, r4 R4),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+		return ApplyView64Ctx(ctx, t1, t2, t3, t4, t5, t6, fn)
+	}
+}
+
+func ApplyView64Err[R1, R2, R3, R4, T1, T2, T3, T4, T5, T6 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (r1 R1, r2 R2, r3 R3, r4 R4, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+	x1, x2, x3, x4, err := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), nil
+}
+
+func MonadView64Err[R1, R2, R3, R4, T1, T2, T3, T4, T5, T6 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (r1 R1, r2 R2, r3 R3, r4 R4, err error),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+		return ApplyView64Err(t1, t2, t3, t4, t5, t6, fn)
+	}
+}
+
+func ApplyView64CtxErr[R1, R2, R3, R4, T1, T2, T3, T4, T5, T6 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (r1 R1, r2 R2, r3 R3, r4 R4, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+	x1, x2, x3, x4, err := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), nil
+}
+
+func MonadView64CtxErr[R1, R2, R3, R4, T1, T2, T3, T4, T5, T6 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (r1 R1, r2 R2, r3 R3, r4 R4, err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+		return ApplyView64CtxErr(ctx, t1, t2, t3, t4, t5, t6, fn)
+	}
+}
+
+func ApplyView65[R1, R2, R3, R4, R5, T1, T2, T3, T4, T5, T6 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+	x1, x2, x3, x4, x5 := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), Of(x5).View()
+}
+
+func MonadView65[R1, R2, R3, R4, R5, T1, T2, T3, T4, T5, T6 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+		return ApplyView65(t1, t2, t3, t4, t5, t6, fn)
+	}
+}
+
+func ApplyView65Ctx[R1, R2, R3, R4, R5, T1, T2, T3, T4, T5, T6 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+	x1, x2, x3, x4, x5 := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), Of(x5).View()
+}
+
+func MonadView65Ctx[R1, R2, R3, R4, R5, T1, T2, T3, T4, T5, T6 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+		return ApplyView65Ctx(ctx, t1, t2, t3, t4, t5, t6, fn)
+	}
+}
+
+func ApplyView65Err[R1, R2, R3, R4, R5, T1, T2, T3, T4, T5, T6 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+	x1, x2, x3, x4, x5, err := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), Of(x5).View(), nil
+}
+
+func MonadView65Err[R1, R2, R3, R4, R5, T1, T2, T3, T4, T5, T6 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+		return ApplyView65Err(t1, t2, t3, t4, t5, t6, fn)
+	}
+}
+
+func ApplyView65CtxErr[R1, R2, R3, R4, R5, T1, T2, T3, T4, T5, T6 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+	x1, x2, x3, x4, x5, err := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), Of(x5).View(), nil
+}
+
+func MonadView65CtxErr[R1, R2, R3, R4, R5, T1, T2, T3, T4, T5, T6 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+		return ApplyView65CtxErr(ctx, t1, t2, t3, t4, t5, t6, fn)
+	}
+}
+
+func ApplyView7Void[T1, T2, T3, T4, T5, T6, T7 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7),
+) {
+	fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val())
+}
+
+func MonadView7Void[T1, T2, T3, T4, T5, T6, T7 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) {
+		ApplyView7Void(t1, t2, t3, t4, t5, t6, t7, fn)
+	}
+}
+
+func ApplyView7VoidCtx[T1, T2, T3, T4, T5, T6, T7 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7),
+) {
+	fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val())
+}
+
+func MonadView7VoidCtx[T1, T2, T3, T4, T5, T6, T7 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) {
+		ApplyView7VoidCtx(ctx, t1, t2, t3, t4, t5, t6, t7, fn)
+	}
+}
+
+func ApplyView7VoidErr[T1, T2, T3, T4, T5, T6, T7 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (err error),
+) (err error) {
+	return fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val())
+}
+
+func MonadView7VoidErr[T1, T2, T3, T4, T5, T6, T7 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (err error),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (err error) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (err error) {
+		return ApplyView7VoidErr(t1, t2, t3, t4, t5, t6, t7, fn)
+	}
+}
+
+func ApplyView7VoidCtxErr[T1, T2, T3, T4, T5, T6, T7 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (err error),
+) (err error) {
+	return fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val())
+}
+
+func MonadView7VoidCtxErr[T1, T2, T3, T4, T5, T6, T7 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (err error) {
+		return ApplyView7VoidCtxErr(ctx, t1, t2, t3, t4, t5, t6, t7, fn)
+	}
+}
+
+func ApplyView7[R1, T1, T2, T3, T4, T5, T6, T7 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (r1 R1),
+) (r1 View[R1]) {
+	x1 := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val())
+
+	return Of(x1).View()
+}
+
+func MonadView7[R1, T1, T2, T3, T4, T5, T6, T7 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (r1 R1),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (r1 View[R1]) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (r1 View[R1]) {
+		return ApplyView7(t1, t2, t3, t4, t5, t6, t7, fn)
+	}
+}
+
+func ApplyView7Ctx[R1, T1, T2, T3, T4, T5, T6, T7 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (r1 R1),
+) (r1 View[R1]) {
+	x1 := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val())
+
+	return Of(x1).View()
+}
+
+func MonadView7Ctx[R1, T1, T2, T3, T4, T5, T6, T7 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (r1 R1),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (r1 View[R1]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (r1 View[R1]) {
+		return ApplyView7Ctx(ctx, t1, t2, t3, t4, t5, t6, t7, fn)
+	}
+}
+
+func ApplyView7Err[R1, T1, T2, T3, T4, T5, T6, T7 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (r1 R1, err error),
+) (r1 View[R1], err error) {
+	x1, err := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), nil
+}
+
+func MonadView7Err[R1, T1, T2, T3, T4, T5, T6, T7 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (r1 R1, err error),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (r1 View[R1], err error) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (r1 View[R1], err error) {
+		return ApplyView7Err(t1, t2, t3, t4, t5, t6, t7, fn)
+	}
+}
+
+func ApplyView7CtxErr[R1, T1, T2, T3, T4, T5, T6, T7 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (r1 R1, err error),
+) (r1 View[R1], err error) {
+	x1, err := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), nil
+}
+
+func MonadView7CtxErr[R1, T1, T2, T3, T4, T5, T6, T7 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (r1 R1, err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (r1 View[R1], err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (r1 View[R1], err error) {
+		return ApplyView7CtxErr(ctx, t1, t2, t3, t4, t5, t6, t7, fn)
+	}
+}
+
+func ApplyView72[R1, R2, T1, T2, T3, T4, T5, T6, T7 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (r1 R1, r2 R2),
+) (r1 View[R1], r2 View[R2]) {
+	x1, x2 := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val())
+
+	return Of(x1).View(), Of(x2).View()
+}
+
+func MonadView72[R1, R2, T1, T2, T3, T4, T5, T6, T7 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (r1 R1, r2 R2),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (r1 View[R1], r2 View[R2]) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (r1 View[R1], r2 View[R2]) {
+		return ApplyView72(t1, t2, t3, t4, t5, t6, t7, fn)
+	}
+}
+
+func ApplyView72Ctx[R1, R2, T1, T2, T3, T4, T5, T6, T7 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (r1 R1, r2 R2),
+) (r1 View[R1], r2 View[R2]) {
+	x1, x2 := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val())
+
+	return Of(x1).View(), Of(x2).View()
+}
+
+func MonadView72Ctx[R1, R2, T1, T2, T3, T4, T5, T6, T7 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (r1 R1, r2 R2),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (r1 View[R1], r2 View[R2]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (r1 View[R1], r2 View[R2]) {
+		return ApplyView72Ctx(ctx, t1, t2, t3, t4, t5, t6, t7, fn)
+	}
+}
+
+func ApplyView72Err[R1, R2, T1, T2, T3, T4, T5, T6, T7 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (r1 R1, r2 R2, err error),
+) (r1 View[R1], r2 View[R2], err error) {
+	x1, x2, err := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), nil
+}
+
+func MonadView72Err[R1, R2, T1, T2, T3, T4, T5, T6, T7 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (r1 R1, r2 R2, err error),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (r1 View[R1], r2 View[R2], err error) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (r1 View[R1], r2 View[R2], err error) {
+		return ApplyView72Err(t1, t2, t3, t4, t5, t6, t7, fn)
+	}
+}
+
+func ApplyView72CtxErr[R1, R2, T1, T2, T3, T4, T5, T6, T7 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (r1 R1, r2 R2, err error),
+) (r1 View[R1], r2 View[R2], err error) {
+	x1, x2, err := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), nil
+}
+
+func MonadView72CtxErr[R1, R2, T1, T2, T3, T4, T5, T6, T7 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (r1 R1, r2 R2, err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (r1 View[R1], r2 View[R2], err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (r1 View[R1], r2 View[R2], err error) {
+		return ApplyView72CtxErr(ctx, t1, t2, t3, t4, t5, t6, t7, fn)
+	}
+}
+
+func ApplyView73[R1, R2, R3, T1, T2, T3, T4, T5, T6, T7 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (r1 R1, r2 R2, r3 R3),
+) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+	x1, x2, x3 := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View()
+}
+
+func MonadView73[R1, R2, R3, T1, T2, T3, T4, T5, T6, T7 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (r1 R1, r2 R2, r3 R3),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+		return ApplyView73(t1, t2, t3, t4, t5, t6, t7, fn)
+	}
+}
+
+func ApplyView73Ctx[R1, R2, R3, T1, T2, T3, T4, T5, T6, T7 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (r1 R1, r2 R2, r3 R3),
+) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+	x1, x2, x3 := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View()
+}
+
+func MonadView73Ctx[R1, R2, R3, T1, T2, T3, T4, T5, T6, T7 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (r1 R1, r2 R2, r3 R3),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+		return ApplyView73Ctx(ctx, t1, t2, t3, t4, t5, t6, t7, fn)
+	}
+}
+
+func ApplyView73Err[R1, R2, R3, T1, T2, T3, T4, T5, T6, T7 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (r1 R1, r2 R2, r3 R3, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+	x1, x2, x3, err := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), nil
+}
+
+func MonadView73Err[R1, R2, R3, T1, T2, T3, T4, T5, T6, T7 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (r1 R1, r2 R2, r3 R3, err error),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+		return ApplyView73Err(t1, t2, t3, t4, t5, t6, t7, fn)
+	}
+}
+
+func ApplyView73CtxErr[R1, R2, R3, T1, T2, T3, T4, T5, T6, T7 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (r1 R1, r2 R2, r3 R3, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+	x1, x2, x3, err := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), nil
+}
+
+func MonadView73CtxErr[R1, R2, R3, T1, T2, T3, T4, T5, T6, T7 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (r1 R1, r2 R2, r3 R3, err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+		return ApplyView73CtxErr(ctx, t1, t2, t3, t4, t5, t6, t7, fn)
+	}
+}
+
+func ApplyView74[R1, R2, R3, R4, T1, T2, T3, T4, T5, T6, T7 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (r1 R1, r2 R2, r3 R3, r4 R4),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+	x1, x2, x3, x4 := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View()
+}
+
+func MonadView74[R1, R2, R3, R4, T1, T2, T3, T4, T5, T6, T7 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (r1 R1, r2 R2, r3 R3, r4 R4),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+		return ApplyView74(t1, t2, t3, t4, t5, t6, t7, fn)
+	}
+}
+
+func ApplyView74Ctx[R1, R2, R3, R4, T1, T2, T3, T4, T5, T6, T7 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (r1 R1, r2 R2, r3 R3, r4 R4),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+	x1, x2, x3, x4 := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View()
+}
+
+func MonadView74Ctx[R1, R2, R3, R4, T1, T2, T3, T4, T5, T6, T7 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (r1 R1, r2 R2, r3 R3, r4 R4),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+		return ApplyView74Ctx(ctx, t1, t2, t3, t4, t5, t6, t7, fn)
+	}
+}
+
+func ApplyView74Err[R1, R2, R3, R4, T1, T2, T3, T4, T5, T6, T7 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (r1 R1, r2 R2, r3 R3, r4 R4, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+	x1, x2, x3, x4, err := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), nil
+}
+
+func MonadView74Err[R1, R2, R3, R4, T1, T2, T3, T4, T5, T6, T7 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (r1 R1, r2 R2, r3 R3, r4 R4, err error),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+		return ApplyView74Err(t1, t2, t3, t4, t5, t6, t7, fn)
+	}
+}
+
+func ApplyView74CtxErr[R1, R2, R3, R4, T1, T2, T3, T4, T5, T6, T7 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (r1 R1, r2 R2, r3 R3, r4 R4, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+	x1, x2, x3, x4, err := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), nil
+}
+
+func MonadView74CtxErr[R1, R2, R3, R4, T1, T2, T3, T4, T5, T6, T7 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (r1 R1, r2 R2, r3 R3, r4 R4, err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+		return ApplyView74CtxErr(ctx, t1, t2, t3, t4, t5, t6, t7, fn)
+	}
+}
+
+func ApplyView75[R1, R2, R3, R4, R5, T1, T2, T3, T4, T5, T6, T7 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+	x1, x2, x3, x4, x5 := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), Of(x5).View()
+}
+
+func MonadView75[R1, R2, R3, R4, R5, T1, T2, T3, T4, T5, T6, T7 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+		return ApplyView75(t1, t2, t3, t4, t5, t6, t7, fn)
+	}
+}
+
+func ApplyView75Ctx[R1, R2, R3, R4, R5, T1, T2, T3, T4, T5, T6, T7 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+	x1, x2, x3, x4, x5 := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), Of(x5).View()
+}
+
+func MonadView75Ctx[R1, R2, R3, R4, R5, T1, T2, T3, T4, T5, T6, T7 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+		return ApplyView75Ctx(ctx, t1, t2, t3, t4, t5, t6, t7, fn)
+	}
+}
+
+func ApplyView75Err[R1, R2, R3, R4, R5, T1, T2, T3, T4, T5, T6, T7 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+	x1, x2, x3, x4, x5, err := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), Of(x5).View(), nil
+}
+
+func MonadView75Err[R1, R2, R3, R4, R5, T1, T2, T3, T4, T5, T6, T7 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+		return ApplyView75Err(t1, t2, t3, t4, t5, t6, t7, fn)
+	}
+}
+
+func ApplyView75CtxErr[R1, R2, R3, R4, R5, T1, T2, T3, T4, T5, T6, T7 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+	x1, x2, x3, x4, x5, err := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), Of(x5).View(), nil
+}
+
+func MonadView75CtxErr[R1, R2, R3, R4, R5, T1, T2, T3, T4, T5, T6, T7 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+		return ApplyView75CtxErr(ctx, t1, t2, t3, t4, t5, t6, t7, fn)
+	}
+}
+
+func ApplyView8Void[T1, T2, T3, T4, T5, T6, T7, T8 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8),
+) {
+	fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val())
+}
+
+func MonadView8Void[T1, T2, T3, T4, T5, T6, T7, T8 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) {
+		ApplyView8Void(t1, t2, t3, t4, t5, t6, t7, t8, fn)
+	}
+}
+
+func ApplyView8VoidCtx[T1, T2, T3, T4, T5, T6, T7, T8 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8),
+) {
+	fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val())
+}
+
+func MonadView8VoidCtx[T1, T2, T3, T4, T5, T6, T7, T8 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) {
+		ApplyView8VoidCtx(ctx, t1, t2, t3, t4, t5, t6, t7, t8, fn)
+	}
+}
+
+func ApplyView8VoidErr[T1, T2, T3, T4, T5, T6, T7, T8 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (err error),
+) (err error) {
+	return fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val())
+}
+
+func MonadView8VoidErr[T1, T2, T3, T4, T5, T6, T7, T8 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (err error),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (err error) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (err error) {
+		return ApplyView8VoidErr(t1, t2, t3, t4, t5, t6, t7, t8, fn)
+	}
+}
+
+func ApplyView8VoidCtxErr[T1, T2, T3, T4, T5, T6, T7, T8 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (err error),
+) (err error) {
+	return fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val())
+}
+
+func MonadView8VoidCtxErr[T1, T2, T3, T4, T5, T6, T7, T8 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (err error) {
+		return ApplyView8VoidCtxErr(ctx, t1, t2, t3, t4, t5, t6, t7, t8, fn)
+	}
+}
+
+func ApplyView8[R1, T1, T2, T3, T4, T5, T6, T7, T8 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (r1 R1),
+) (r1 View[R1]) {
+	x1 := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val())
+
+	return Of(x1).View()
+}
+
+func MonadView8[R1, T1, T2, T3, T4, T5, T6, T7, T8 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (r1 R1),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (r1 View[R1]) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (r1 View[R1]) {
+		return ApplyView8(t1, t2, t3, t4, t5, t6, t7, t8, fn)
+	}
+}
+
+func ApplyView8Ctx[R1, T1, T2, T3, T4, T5, T6, T7, T8 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (r1 R1),
+) (r1 View[R1]) {
+	x1 := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val())
+
+	return Of(x1).View()
+}
+
+func MonadView8Ctx[R1, T1, T2, T3, T4, T5, T6, T7, T8 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (r1 R1),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (r1 View[R1]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (r1 View[R1]) {
+		return ApplyView8Ctx(ctx, t1, t2, t3, t4, t5, t6, t7, t8, fn)
+	}
+}
+
+func ApplyView8Err[R1, T1, T2, T3, T4, T5, T6, T7, T8 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (r1 R1, err error),
+) (r1 View[R1], err error) {
+	x1, err := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), nil
+}
+
+func MonadView8Err[R1, T1, T2, T3, T4, T5, T6, T7, T8 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (r1 R1, err error),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (r1 View[R1], err error) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (r1 View[R1], err error) {
+		return ApplyView8Err(t1, t2, t3, t4, t5, t6, t7, t8, fn)
+	}
+}
+
+func ApplyView8CtxErr[R1, T1, T2, T3, T4, T5, T6, T7, T8 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (r1 R1, err error),
+) (r1 View[R1], err error) {
+	x1, err := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), nil
+}
+
+func MonadView8CtxErr[R1, T1, T2, T3, T4, T5, T6, T7, T8 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (r1 R1, err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (r1 View[R1], err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (r1 View[R1], err error) {
+		return ApplyView8CtxErr(ctx, t1, t2, t3, t4, t5, t6, t7, t8, fn)
+	}
+}
+
+func ApplyView82[R1, R2, T1, T2, T3, T4, T5, T6, T7, T8 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (r1 R1, r2 R2),
+) (r1 View[R1], r2 View[R2]) {
+	x1, x2 := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val())
+
+	return Of(x1).View(), Of(x2).View()
+}
+
+func MonadView82[R1, R2, T1, T2, T3, T4, T5, T6, T7, T8 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (r1 R1, r2 R2),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (r1 View[R1], r2 View[R2]) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (r1 View[R1], r2 View[R2]) {
+		return ApplyView82(t1, t2, t3, t4, t5, t6, t7, t8, fn)
+	}
+}
+
+func ApplyView82Ctx[R1, R2, T1, T2, T3, T4, T5, T6, T7, T8 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (r1 R1, r2 R2),
+) (r1 View[R1], r2 View[R2]) {
+	x1, x2 := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val())
+
+	return Of(x1).View(), Of(x2).View()
+}
+
+func MonadView82Ctx[R1, R2, T1, T2, T3, T4, T5, T6, T7, T8 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (r1 R1, r2 R2),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (r1 View[R1], r2 View[R2]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (r1 View[R1], r2 View[R2]) {
+		return ApplyView82Ctx(ctx, t1, t2, t3, t4, t5, t6, t7, t8, fn)
+	}
+}
+
+func ApplyView82Err[R1, R2, T1, T2, T3, T4, T5, T6, T7, T8 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (r1 R1, r2 R2, err error),
+) (r1 View[R1], r2 View[R2], err error) {
+	x1, x2, err := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), nil
+}
+
+func MonadView82Err[R1, R2, T1, T2, T3, T4, T5, T6, T7, T8 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (r1 R1, r2 R2, err error),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (r1 View[R1], r2 View[R2], err error) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (r1 View[R1], r2 View[R2], err error) {
+		return ApplyView82Err(t1, t2, t3, t4, t5, t6, t7, t8, fn)
+	}
+}
+
+func ApplyView82CtxErr[R1, R2, T1, T2, T3, T4, T5, T6, T7, T8 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (r1 R1, r2 R2, err error),
+) (r1 View[R1], r2 View[R2], err error) {
+	x1, x2, err := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), nil
+}
+
+func MonadView82CtxErr[R1, R2, T1, T2, T3, T4, T5, T6, T7, T8 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (r1 R1, r2 R2, err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (r1 View[R1], r2 View[R2], err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (r1 View[R1], r2 View[R2], err error) {
+		return ApplyView82CtxErr(ctx, t1, t2, t3, t4, t5, t6, t7, t8, fn)
+	}
+}
+
+func ApplyView83[R1, R2, R3, T1, T2, T3, T4, T5, T6, T7, T8 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (r1 R1, r2 R2, r3 R3),
+) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+	x1, x2, x3 := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View()
+}
+
+func MonadView83[R1, R2, R3, T1, T2, T3, T4, T5, T6, T7, T8 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (r1 R1, r2 R2, r3 R3),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+		return ApplyView83(t1, t2, t3, t4, t5, t6, t7, t8, fn)
+	}
+}
+
+func ApplyView83Ctx[R1, R2, R3, T1, T2, T3, T4, T5, T6, T7, T8 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (r1 R1, r2 R2, r3 R3),
+) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+	x1, x2, x3 := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View()
+}
+
+func MonadView83Ctx[R1, R2, R3, T1, T2, T3, T4, T5, T6, T7, T8 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (r1 R1, r2 R2, r3 R3),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+		return ApplyView83Ctx(ctx, t1, t2, t3, t4, t5, t6, t7, t8, fn)
+	}
+}
+
+func ApplyView83Err[R1, R2, R3, T1, T2, T3, T4, T5, T6, T7, T8 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (r1 R1, r2 R2, r3 R3, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+	x1, x2, x3, err := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), nil
+}
+
+func MonadView83Err[R1, R2, R3, T1, T2, T3, T4, T5, T6, T7, T8 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (r1 R1, r2 R2, r3 R3, err error),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+		return ApplyView83Err(t1, t2, t3, t4, t5, t6, t7, t8, fn)
+	}
+}
+
+func ApplyView83CtxErr[R1, R2, R3, T1, T2, T3, T4, T5, T6, T7, T8 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (r1 R1, r2 R2, r3 R3, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+	x1, x2, x3, err := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), nil
+}
+
+func MonadView83CtxErr[R1, R2, R3, T1, T2, T3, T4, T5, T6, T7, T8 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (r1 R1, r2 R2, r3 R3, err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+		return ApplyView83CtxErr(ctx, t1, t2, t3, t4, t5, t6, t7, t8, fn)
+	}
+}
+
+func ApplyView84[R1, R2, R3, R4, T1, T2, T3, T4, T5, T6, T7, T8 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (r1 R1, r2 R2, r3 R3, r4 R4),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+	x1, x2, x3, x4 := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View()
+}
+
+func MonadView84[R1, R2, R3, R4, T1, T2, T3, T4, T5, T6, T7, T8 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (r1 R1, r2 R2, r3 R3, r4 R4),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+		return ApplyView84(t1, t2, t3, t4, t5, t6, t7, t8, fn)
+	}
+}
+
+func ApplyView84Ctx[R1, R2, R3, R4, T1, T2, T3, T4, T5, T6, T7, T8 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (r1 R1, r2 R2, r3 R3, r4 R4),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+	x1, x2, x3, x4 := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View()
+}
+
+func MonadView84Ctx[R1, R2, R3, R4, T1, T2, T3, T4, T5, T6, T7, T8 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (r1 R1, r2 R2, r3 R3, r4 R4),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+		return ApplyView84Ctx(ctx, t1, t2, t3, t4, t5, t6, t7, t8, fn)
+	}
+}
+
+func ApplyView84Err[R1, R2, R3, R4, T1, T2, T3, T4, T5, T6, T7, T8 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (r1 R1, r2 R2, r3 R3, r4 R4, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+	x1, x2, x3, x4, err := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), nil
+}
+
+func MonadView84Err[R1, R2, R3, R4, T1, T2, T3, T4, T5, T6, T7, T8 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (r1 R1, r2 R2, r3 R3, r4 R4, err error),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+		return ApplyView84Err(t1, t2, t3, t4, t5, t6, t7, t8, fn)
+	}
+}
+
+func ApplyView84CtxErr[R1, R2, R3, R4, T1, T2, T3, T4, T5, T6, T7, T8 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (r1 R1, r2 R2, r3 R3, r4 R4, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+	x1, x2, x3, x4, err := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), nil
+}
+
+func MonadView84CtxErr[R1, R2, R3, R4, T1, T2, T3, T4, T5, T6, T7, T8 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (r1 R1, r2 R2, r3 R3, r4 R4, err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+		return ApplyView84CtxErr(ctx, t1, t2, t3, t4, t5, t6, t7, t8, fn)
+	}
+}
+
+func ApplyView85[R1, R2, R3, R4, R5, T1, T2, T3, T4, T5, T6, T7, T8 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+	x1, x2, x3, x4, x5 := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), Of(x5).View()
+}
+
+func MonadView85[R1, R2, R3, R4, R5, T1, T2, T3, T4, T5, T6, T7, T8 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+		return ApplyView85(t1, t2, t3, t4, t5, t6, t7, t8, fn)
+	}
+}
+
+func ApplyView85Ctx[R1, R2, R3, R4, R5, T1, T2, T3, T4, T5, T6, T7, T8 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+	x1, x2, x3, x4, x5 := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), Of(x5).View()
+}
+
+func MonadView85Ctx[R1, R2, R3, R4, R5, T1, T2, T3, T4, T5, T6, T7, T8 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+		return ApplyView85Ctx(ctx, t1, t2, t3, t4, t5, t6, t7, t8, fn)
+	}
+}
+
+func ApplyView85Err[R1, R2, R3, R4, R5, T1, T2, T3, T4, T5, T6, T7, T8 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+	x1, x2, x3, x4, x5, err := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), Of(x5).View(), nil
+}
+
+func MonadView85Err[R1, R2, R3, R4, R5, T1, T2, T3, T4, T5, T6, T7, T8 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+		return ApplyView85Err(t1, t2, t3, t4, t5, t6, t7, t8, fn)
+	}
+}
+
+func ApplyView85CtxErr[R1, R2, R3, R4, R5, T1, T2, T3, T4, T5, T6, T7, T8 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+	x1, x2, x3, x4, x5, err := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), Of(x5).View(), nil
+}
+
+func MonadView85CtxErr[R1, R2, R3, R4, R5, T1, T2, T3, T4, T5, T6, T7, T8 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+		return ApplyView85CtxErr(ctx, t1, t2, t3, t4, t5, t6, t7, t8, fn)
+	}
+}
+
+func ApplyView9Void[T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9),
+) {
+	fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val(), t9.Val())
+}
+
+func MonadView9Void[T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) {
+		ApplyView9Void(t1, t2, t3, t4, t5, t6, t7, t8, t9, fn)
+	}
+}
+
+func ApplyView9VoidCtx[T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9),
+) {
+	fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val(), t9.Val())
+}
+
+func MonadView9VoidCtx[T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) {
+		ApplyView9VoidCtx(ctx, t1, t2, t3, t4, t5, t6, t7, t8, t9, fn)
+	}
+}
+
+func ApplyView9VoidErr[T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (err error),
+) (err error) {
+	return fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val(), t9.Val())
+}
+
+func MonadView9VoidErr[T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (err error),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (err error) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (err error) {
+		return ApplyView9VoidErr(t1, t2, t3, t4, t5, t6, t7, t8, t9, fn)
+	}
+}
+
+func ApplyView9VoidCtxErr[T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (err error),
+) (err error) {
+	return fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val(), t9.Val())
+}
+
+func MonadView9VoidCtxErr[T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (err error) {
+		return ApplyView9VoidCtxErr(ctx, t1, t2, t3, t4, t5, t6, t7, t8, t9, fn)
+	}
+}
+
+func ApplyView9[R1, T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (r1 R1),
+) (r1 View[R1]) {
+	x1 := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val(), t9.Val())
+
+	return Of(x1).View()
+}
+
+func MonadView9[R1, T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (r1 R1),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (r1 View[R1]) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (r1 View[R1]) {
+		return ApplyView9(t1, t2, t3, t4, t5, t6, t7, t8, t9, fn)
+	}
+}
+
+func ApplyView9Ctx[R1, T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (r1 R1),
+) (r1 View[R1]) {
+	x1 := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val(), t9.Val())
+
+	return Of(x1).View()
+}
+
+func MonadView9Ctx[R1, T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (r1 R1),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (r1 View[R1]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (r1 View[R1]) {
+		return ApplyView9Ctx(ctx, t1, t2, t3, t4, t5, t6, t7, t8, t9, fn)
+	}
+}
+
+func ApplyView9Err[R1, T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (r1 R1, err error),
+) (r1 View[R1], err error) {
+	x1, err := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val(), t9.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), nil
+}
+
+func MonadView9Err[R1, T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (r1 R1, err error),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (r1 View[R1], err error) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (r1 View[R1], err error) {
+		return ApplyView9Err(t1, t2, t3, t4, t5, t6, t7, t8, t9, fn)
+	}
+}
+
+func ApplyView9CtxErr[R1, T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (r1 R1, err error),
+) (r1 View[R1], err error) {
+	x1, err := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val(), t9.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), nil
+}
+
+func MonadView9CtxErr[R1, T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (r1 R1, err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (r1 View[R1], err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (r1 View[R1], err error) {
+		return ApplyView9CtxErr(ctx, t1, t2, t3, t4, t5, t6, t7, t8, t9, fn)
+	}
+}
+
+func ApplyView92[R1, R2, T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (r1 R1, r2 R2),
+) (r1 View[R1], r2 View[R2]) {
+	x1, x2 := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val(), t9.Val())
+
+	return Of(x1).View(), Of(x2).View()
+}
+
+func MonadView92[R1, R2, T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (r1 R1, r2 R2),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (r1 View[R1], r2 View[R2]) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (r1 View[R1], r2 View[R2]) {
+		return ApplyView92(t1, t2, t3, t4, t5, t6, t7, t8, t9, fn)
+	}
+}
+
+func ApplyView92Ctx[R1, R2, T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (r1 R1, r2 R2),
+) (r1 View[R1], r2 View[R2]) {
+	x1, x2 := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val(), t9.Val())
+
+	return Of(x1).View(), Of(x2).View()
+}
+
+func MonadView92Ctx[R1, R2, T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (r1 R1, r2 R2),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (r1 View[R1], r2 View[R2]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (r1 View[R1], r2 View[R2]) {
+		return ApplyView92Ctx(ctx, t1, t2, t3, t4, t5, t6, t7, t8, t9, fn)
+	}
+}
+
+func ApplyView92Err[R1, R2, T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (r1 R1, r2 R2, err error),
+) (r1 View[R1], r2 View[R2], err error) {
+	x1, x2, err := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val(), t9.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), nil
+}
+
+func MonadView92Err[R1, R2, T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (r1 R1, r2 R2, err error),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (r1 View[R1], r2 View[R2], err error) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (r1 View[R1], r2 View[R2], err error) {
+		return ApplyView92Err(t1, t2, t3, t4, t5, t6, t7, t8, t9, fn)
+	}
+}
+
+func ApplyView92CtxErr[R1, R2, T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (r1 R1, r2 R2, err error),
+) (r1 View[R1], r2 View[R2], err error) {
+	x1, x2, err := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val(), t9.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), nil
+}
+
+func MonadView92CtxErr[R1, R2, T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (r1 R1, r2 R2, err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (r1 View[R1], r2 View[R2], err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (r1 View[R1], r2 View[R2], err error) {
+		return ApplyView92CtxErr(ctx, t1, t2, t3, t4, t5, t6, t7, t8, t9, fn)
+	}
+}
+
+func ApplyView93[R1, R2, R3, T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (r1 R1, r2 R2, r3 R3),
+) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+	x1, x2, x3 := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val(), t9.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View()
+}
+
+func MonadView93[R1, R2, R3, T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (r1 R1, r2 R2, r3 R3),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+		return ApplyView93(t1, t2, t3, t4, t5, t6, t7, t8, t9, fn)
+	}
+}
+
+func ApplyView93Ctx[R1, R2, R3, T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (r1 R1, r2 R2, r3 R3),
+) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+	x1, x2, x3 := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val(), t9.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View()
+}
+
+func MonadView93Ctx[R1, R2, R3, T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (r1 R1, r2 R2, r3 R3),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (r1 View[R1], r2 View[R2], r3 View[R3]) {
+		return ApplyView93Ctx(ctx, t1, t2, t3, t4, t5, t6, t7, t8, t9, fn)
+	}
+}
+
+func ApplyView93Err[R1, R2, R3, T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (r1 R1, r2 R2, r3 R3, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+	x1, x2, x3, err := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val(), t9.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), nil
+}
+
+func MonadView93Err[R1, R2, R3, T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (r1 R1, r2 R2, r3 R3, err error),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+		return ApplyView93Err(t1, t2, t3, t4, t5, t6, t7, t8, t9, fn)
+	}
+}
+
+func ApplyView93CtxErr[R1, R2, R3, T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (r1 R1, r2 R2, r3 R3, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+	x1, x2, x3, err := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val(), t9.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), nil
+}
+
+func MonadView93CtxErr[R1, R2, R3, T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (r1 R1, r2 R2, r3 R3, err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (r1 View[R1], r2 View[R2], r3 View[R3], err error) {
+		return ApplyView93CtxErr(ctx, t1, t2, t3, t4, t5, t6, t7, t8, t9, fn)
+	}
+}
+
+func ApplyView94[R1, R2, R3, R4, T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (r1 R1, r2 R2, r3 R3, r4 R4),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+	x1, x2, x3, x4 := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val(), t9.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View()
+}
+
+func MonadView94[R1, R2, R3, R4, T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (r1 R1, r2 R2, r3 R3, r4 R4),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+		return ApplyView94(t1, t2, t3, t4, t5, t6, t7, t8, t9, fn)
+	}
+}
+
+func ApplyView94Ctx[R1, R2, R3, R4, T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (r1 R1, r2 R2, r3 R3, r4 R4),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+	x1, x2, x3, x4 := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val(), t9.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View()
+}
+
+func MonadView94Ctx[R1, R2, R3, R4, T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (r1 R1, r2 R2, r3 R3, r4 R4),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4]) {
+		return ApplyView94Ctx(ctx, t1, t2, t3, t4, t5, t6, t7, t8, t9, fn)
+	}
+}
+
+func ApplyView94Err[R1, R2, R3, R4, T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (r1 R1, r2 R2, r3 R3, r4 R4, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+	x1, x2, x3, x4, err := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val(), t9.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), nil
+}
+
+func MonadView94Err[R1, R2, R3, R4, T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (r1 R1, r2 R2, r3 R3, r4 R4, err error),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+		return ApplyView94Err(t1, t2, t3, t4, t5, t6, t7, t8, t9, fn)
+	}
+}
+
+func ApplyView94CtxErr[R1, R2, R3, R4, T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (r1 R1, r2 R2, r3 R3, r4 R4, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+	x1, x2, x3, x4, err := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val(), t9.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), nil
+}
+
+func MonadView94CtxErr[R1, R2, R3, R4, T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (r1 R1, r2 R2, r3 R3, r4 R4, err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], err error) {
+		return ApplyView94CtxErr(ctx, t1, t2, t3, t4, t5, t6, t7, t8, t9, fn)
+	}
+}
+
+func ApplyView95[R1, R2, R3, R4, R5, T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+	x1, x2, x3, x4, x5 := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val(), t9.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), Of(x5).View()
+}
+
+func MonadView95[R1, R2, R3, R4, R5, T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+		return ApplyView95(t1, t2, t3, t4, t5, t6, t7, t8, t9, fn)
+	}
+}
+
+func ApplyView95Ctx[R1, R2, R3, R4, R5, T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+	x1, x2, x3, x4, x5 := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val(), t9.Val())
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), Of(x5).View()
+}
+
+func MonadView95Ctx[R1, R2, R3, R4, R5, T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5]) {
+		return ApplyView95Ctx(ctx, t1, t2, t3, t4, t5, t6, t7, t8, t9, fn)
+	}
+}
+
+func ApplyView95Err[R1, R2, R3, R4, R5, T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+	x1, x2, x3, x4, x5, err := fn(t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val(), t9.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), Of(x5).View(), nil
+}
+
+func MonadView95Err[R1, R2, R3, R4, R5, T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error),
+) func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+	return func(t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+		return ApplyView95Err(t1, t2, t3, t4, t5, t6, t7, t8, t9, fn)
+	}
+}
+
+func ApplyView95CtxErr[R1, R2, R3, R4, R5, T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9],
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error),
+) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+	x1, x2, x3, x4, x5, err := fn(ctx, t1.Val(), t2.Val(), t3.Val(), t4.Val(), t5.Val(), t6.Val(), t7.Val(), t8.Val(), t9.Val())
+	if err != nil {
+		return
+	}
+
+	return Of(x1).View(), Of(x2).View(), Of(x3).View(), Of(x4).View(), Of(x5).View(), nil
+}
+
+func MonadView95CtxErr[R1, R2, R3, R4, R5, T1, T2, T3, T4, T5, T6, T7, T8, T9 any](
+	fn func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error),
+) func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+	return func(ctx context.Context, t1 View[T1], t2 View[T2], t3 View[T3], t4 View[T4], t5 View[T5], t6 View[T6], t7 View[T7], t8 View[T8], t9 View[T9]) (r1 View[R1], r2 View[R2], r3 View[R3], r4 View[R4], r5 View[R5], err error) {
+		return ApplyView95CtxErr(ctx, t1, t2, t3, t4, t5, t6, t7, t8, t9, fn)
+	}
+}
@@ -0,0 +1,110 @@
+package ref_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sr9000/go-ptr-tools/ref"
+)
+
+type account struct {
+	Name    string
+	Balance int
+}
+
+func TestView(t *testing.T) {
+	t.Parallel()
+
+	r := ref.Of(account{Name: "alice", Balance: 10})
+	v := r.View()
+
+	require.Equal(t, account{Name: "alice", Balance: 10}, v.Val())
+
+	r.Ptr().Balance = 20
+	require.Equal(t, 20, v.Val().Balance, "views share the referenced value")
+
+	copied := v.Val()
+	copied.Balance = 30
+	require.Equal(t, 20, r.Val().Balance, "Val returns a copy")
+}
+
+func TestField(t *testing.T) {
+	t.Parallel()
+
+	r := ref.Of(account{Name: "alice"})
+	name := ref.Field(r.View(), func(a *account) *string { return &a.Name })
+
+	require.Equal(t, "alice", name.Val())
+
+	r.Ptr().Name = "bob"
+	require.Equal(t, "bob", name.Val(), "fields are viewed, not copied")
+}
+
+func TestApplyView(t *testing.T) {
+	t.Parallel()
+
+	a, b := ref.Of(2).View(), ref.Of(3).View()
+
+	t.Run("void", func(t *testing.T) {
+		t.Parallel()
+
+		var got int
+
+		ref.ApplyView2Void(a, b, func(x, y int) { got = x * y })
+		require.Equal(t, 6, got)
+	})
+
+	t.Run("result", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, "2", ref.ApplyView(a, strconv.Itoa).Val())
+
+		sum, diff := ref.ApplyView22(a, b, func(x, y int) (int, int) { return x + y, x - y })
+		require.Equal(t, 5, sum.Val())
+		require.Equal(t, -1, diff.Val())
+	})
+
+	t.Run("ctx err", func(t *testing.T) {
+		t.Parallel()
+
+		errOdd := errors.New("odd")
+		half := func(_ context.Context, x int) (int, error) {
+			if x%2 != 0 {
+				return 0, errOdd
+			}
+
+			return x / 2, nil
+		}
+
+		res, err := ref.ApplyViewCtxErr(t.Context(), a, half)
+		require.NoError(t, err)
+		require.Equal(t, 1, res.Val())
+
+		res, err = ref.ApplyViewCtxErr(t.Context(), b, half)
+		require.ErrorIs(t, err, errOdd)
+		require.Equal(t, ref.View[int]{}, res, "just like ref.ApplyErr, the View is valid only without error")
+	})
+
+	t.Run("err", func(t *testing.T) {
+		t.Parallel()
+
+		n, err := ref.ApplyViewErr(ref.Of("42").View(), strconv.Atoi)
+		require.NoError(t, err)
+		require.Equal(t, 42, n.Val())
+
+		n, err = ref.ApplyViewErr(ref.Of("x").View(), strconv.Atoi)
+		require.Error(t, err)
+		require.Equal(t, ref.View[int]{}, n)
+	})
+
+	t.Run("monad", func(t *testing.T) {
+		t.Parallel()
+
+		mul := ref.MonadView2(func(x, y int) int { return x * y })
+		require.Equal(t, 36, mul(mul(a, b), mul(b, a)).Val())
+	})
+}
@@ -0,0 +1,76 @@
+// Code generated by generate_monad.go; DO NOT EDIT.
+package ref
+
+//go:generate go run internal/generate/generate_monad.go
+
+// Map is an alias of Apply: it returns a new Ref to the result of fn applied to the referenced value.
+func Map[T1, R any](
+	t1 Ref[T1],
+	fn func(t1 T1) R,
+) Ref[R] {
+	return Apply(t1, fn)
+}
+
+// Zip2 is an alias of Apply2: it returns a new Ref to the result of fn applied to the referenced values.
+func Zip2[T1, T2, R any](
+	t1 Ref[T1], t2 Ref[T2],
+	fn func(t1 T1, t2 T2) R,
+) Ref[R] {
+	return Apply2(t1, t2, fn)
+}
+
+// Zip3 is an alias of Apply3: it returns a new Ref to the result of fn applied to the referenced values.
+func Zip3[T1, T2, T3, R any](
+	t1 Ref[T1], t2 Ref[T2], t3 Ref[T3],
+	fn func(t1 T1, t2 T2, t3 T3) R,
+) Ref[R] {
+	return Apply3(t1, t2, t3, fn)
+}
+
+// Zip4 is an alias of Apply4: it returns a new Ref to the result of fn applied to the referenced values.
+func Zip4[T1, T2, T3, T4, R any](
+	t1 Ref[T1], t2 Ref[T2], t3 Ref[T3], t4 Ref[T4],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4) R,
+) Ref[R] {
+	return Apply4(t1, t2, t3, t4, fn)
+}
+
+// Zip5 is an alias of Apply5: it returns a new Ref to the result of fn applied to the referenced values.
+func Zip5[T1, T2, T3, T4, T5, R any](
+	t1 Ref[T1], t2 Ref[T2], t3 Ref[T3], t4 Ref[T4], t5 Ref[T5],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) R,
+) Ref[R] {
+	return Apply5(t1, t2, t3, t4, t5, fn)
+}
+
+// Zip6 is an alias of Apply6: it returns a new Ref to the result of fn applied to the referenced values.
+func Zip6[T1, T2, T3, T4, T5, T6, R any](
+	t1 Ref[T1], t2 Ref[T2], t3 Ref[T3], t4 Ref[T4], t5 Ref[T5], t6 Ref[T6],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) R,
+) Ref[R] {
+	return Apply6(t1, t2, t3, t4, t5, t6, fn)
+}
+
+// Zip7 is an alias of Apply7: it returns a new Ref to the result of fn applied to the referenced values.
+func Zip7[T1, T2, T3, T4, T5, T6, T7, R any](
+	t1 Ref[T1], t2 Ref[T2], t3 Ref[T3], t4 Ref[T4], t5 Ref[T5], t6 Ref[T6], t7 Ref[T7],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) R,
+) Ref[R] {
+	return Apply7(t1, t2, t3, t4, t5, t6, t7, fn)
+}
+
+// Zip8 is an alias of Apply8: it returns a new Ref to the result of fn applied to the referenced values.
+func Zip8[T1, T2, T3, T4, T5, T6, T7, T8, R any](
+	t1 Ref[T1], t2 Ref[T2], t3 Ref[T3], t4 Ref[T4], t5 Ref[T5], t6 Ref[T6], t7 Ref[T7], t8 Ref[T8],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) R,
+) Ref[R] {
+	return Apply8(t1, t2, t3, t4, t5, t6, t7, t8, fn)
+}
+
+// Zip9 is an alias of Apply9: it returns a new Ref to the result of fn applied to the referenced values.
+func Zip9[T1, T2, T3, T4, T5, T6, T7, T8, T9, R any](
+	t1 Ref[T1], t2 Ref[T2], t3 Ref[T3], t4 Ref[T4], t5 Ref[T5], t6 Ref[T6], t7 Ref[T7], t8 Ref[T8], t9 Ref[T9],
+	fn func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) R,
+) Ref[R] {
+	return Apply9(t1, t2, t3, t4, t5, t6, t7, t8, t9, fn)
+}
@@ -0,0 +1,271 @@
+package schema
+
+import (
+	"cmp"
+	"encoding"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sr9000/go-ptr-tools/opt"
+	"github.com/sr9000/go-ptr-tools/ref"
+)
+
+var (
+	pkgPathRe = regexp.MustCompile(`(?:[\w.-]+/)*[\w-]+\.`)
+	nonNameRe = regexp.MustCompile(`[^A-Za-z0-9]+`)
+)
+
+type generator struct {
+	names map[reflect.Type]string // named structs already put into defs
+	defs  map[string]*Schema
+}
+
+// schemaOf returns the schema of values of the type t, pointers and opt.Opt values are nullable.
+func (g *generator) schemaOf(t reflect.Type) *Schema {
+	switch {
+	case opt.IsOptType(t):
+		return nullable(g.schemaOf(opt.ElemType(t)))
+	case ref.IsRefType(t):
+		return g.schemaOf(refElemType(t))
+	case t == reflect.TypeFor[time.Time]():
+		return &Schema{Type: Type{"string"}, Format: "date-time"}
+	case reflect.PointerTo(t).Implements(reflect.TypeFor[encoding.TextMarshaler]()):
+		return &Schema{Type: Type{"string"}}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		return nullable(g.schemaOf(t.Elem()))
+	case reflect.Bool:
+		return &Schema{Type: Type{"boolean"}}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return &Schema{Type: Type{"integer"}}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return &Schema{Type: Type{"integer"}, Minimum: new(float64)}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: Type{"number"}}
+	case reflect.String:
+		return &Schema{Type: Type{"string"}}
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: Type{"string"}, ContentEncoding: "base64"}
+		}
+
+		return &Schema{Type: Type{"array"}, Items: g.schemaOf(t.Elem())}
+	case reflect.Array:
+		n := t.Len()
+
+		return &Schema{Type: Type{"array"}, Items: g.schemaOf(t.Elem()), MinItems: &n, MaxItems: &n}
+	case reflect.Map:
+		return &Schema{Type: Type{"object"}, AdditionalProperties: g.schemaOf(t.Elem())}
+	case reflect.Struct:
+		return g.structRef(t)
+	default: // interfaces accept anything
+		return &Schema{}
+	}
+}
+
+// structRef returns the reference to the named struct put into defs, or the inline schema of the anonymous one.
+func (g *generator) structRef(t reflect.Type) *Schema {
+	if t.Name() == "" {
+		return g.object(t)
+	}
+
+	name, ok := g.names[t]
+	if !ok {
+		name = g.defName(t)
+		g.names[t] = name
+		g.defs[name] = &Schema{} // reserve the name, recursive types refer to it
+		*g.defs[name] = *g.object(t)
+	}
+
+	return &Schema{Ref: "#/$defs/" + name}
+}
+
+// defName returns the unique name of the type in defs: "Page[pkg.User]" becomes "Page_User".
+func (g *generator) defName(t reflect.Type) string {
+	base := strings.Trim(nonNameRe.ReplaceAllString(pkgPathRe.ReplaceAllString(t.Name(), ""), "_"), "_")
+
+	name := base
+	for i := 2; g.defs[name] != nil; i++ {
+		name = base + "_" + strconv.Itoa(i)
+	}
+
+	return name
+}
+
+func (g *generator) object(t reflect.Type) *Schema {
+	s := &Schema{Type: Type{"object"}, Properties: make(map[string]*Schema)}
+	g.properties(s, t)
+
+	return s
+}
+
+// properties adds properties of the struct t to s, embedded structs are inlined.
+func (g *generator) properties(s *Schema, t reflect.Type) {
+	for _, f := range jsonFields(t) {
+		omitempty := slices.Contains(f.options, "omitempty") || slices.Contains(f.options, "omitzero")
+		prop, required := g.property(f.typ, omitempty)
+
+		s.Properties[f.name] = prop
+		if required {
+			s.Required = append(s.Required, f.name)
+		}
+	}
+}
+
+// jsonField is a field of a struct encoded by encoding/json, possibly promoted from an embedded struct.
+type jsonField struct {
+	name    string
+	tagged  bool
+	index   []int
+	typ     reflect.Type
+	options []string
+}
+
+// jsonFields returns the fields of the struct t in the order encoding/json encodes them, following its rules
+// for fields promoted from embedded structs: the shallowest field wins, a tagged one wins among fields
+// of the same depth, and the other conflicting fields are dropped.
+func jsonFields(t reflect.Type) []jsonField {
+	var (
+		fields  []jsonField
+		next    = []jsonField{{typ: t}}
+		visited = make(map[reflect.Type]bool)
+	)
+
+	for len(next) > 0 {
+		current := next
+		next = nil
+
+		// the same struct embedded twice at one depth yields conflicting fields, so it is visited twice
+		for _, embedded := range current {
+			if visited[embedded.typ] {
+				continue
+			}
+
+			for i := range embedded.typ.NumField() {
+				field := embedded.typ.Field(i)
+				tag := field.Tag.Get("json")
+
+				if tag == "-" {
+					continue
+				}
+
+				name, options, _ := strings.Cut(tag, ",")
+				index := append(slices.Clone(embedded.index), i)
+
+				if field.Anonymous && name == "" {
+					typ := field.Type
+					if typ.Kind() == reflect.Pointer {
+						typ = typ.Elem()
+					}
+
+					if typ.Kind() == reflect.Struct && !opt.IsOptType(typ) && !ref.IsRefType(typ) {
+						next = append(next, jsonField{index: index, typ: typ})
+
+						continue
+					}
+				}
+
+				if !field.IsExported() {
+					continue
+				}
+
+				tagged := name != ""
+				if !tagged {
+					name = field.Name
+				}
+
+				fields = append(fields, jsonField{
+					name: name, tagged: tagged, index: index, typ: field.Type, options: strings.Split(options, ","),
+				})
+			}
+		}
+
+		for _, embedded := range current {
+			visited[embedded.typ] = true
+		}
+	}
+
+	return dominantFields(fields)
+}
+
+// dominantFields keeps the fields winning over the others with the same name, in the order of their indexes.
+func dominantFields(fields []jsonField) []jsonField {
+	slices.SortStableFunc(fields, func(a, b jsonField) int {
+		if c := cmp.Compare(a.name, b.name); c != 0 {
+			return c
+		}
+
+		if c := cmp.Compare(len(a.index), len(b.index)); c != 0 {
+			return c
+		}
+
+		switch {
+		case a.tagged == b.tagged:
+			return 0
+		case a.tagged:
+			return -1
+		default:
+			return 1
+		}
+	})
+
+	res := make([]jsonField, 0, len(fields))
+
+	for i := 0; i < len(fields); {
+		j := i + 1
+		for j < len(fields) && fields[j].name == fields[i].name {
+			j++
+		}
+
+		if j == i+1 || len(fields[i].index) != len(fields[i+1].index) || fields[i].tagged != fields[i+1].tagged {
+			res = append(res, fields[i])
+		}
+
+		i = j
+	}
+
+	slices.SortFunc(res, func(a, b jsonField) int {
+		return slices.Compare(a.index, b.index)
+	})
+
+	return res
+}
+
+// property returns the schema of the struct field of the type t and whether it is required.
+func (g *generator) property(t reflect.Type, omitempty bool) (*Schema, bool) {
+	switch {
+	case opt.IsOptType(t):
+		return g.schemaOf(opt.ElemType(t)), false // Opt[*T] elements are nullable
+	case ref.IsRefType(t):
+		return g.schemaOf(refElemType(t)), true
+	case t.Kind() == reflect.Pointer:
+		return g.schemaOf(t.Elem()), false // **T elements are nullable
+	default:
+		return g.schemaOf(t), !omitempty
+	}
+}
+
+// nullable adds null to the allowed types of the schema.
+func nullable(s *Schema) *Schema {
+	switch {
+	case s.Ref != "":
+		return &Schema{AnyOf: []*Schema{s, {Type: Type{"null"}}}}
+	case len(s.Type) == 0 || slices.Contains(s.Type, "null"):
+		return s // anything, or already nullable
+	default:
+		res := *s
+		res.Type = append(append(Type{}, s.Type...), "null")
+
+		return &res
+	}
+}
+
+func refElemType(t reflect.Type) reflect.Type {
+	return ref.ReflectPtr(reflect.Zero(t)).Type().Elem()
+}
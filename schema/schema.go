@@ -0,0 +1,82 @@
+// Package schema generates JSON Schemas (Draft 2020-12) from Go types, understanding optionality of
+// pointers, opt.Opt and ref.Ref values.
+//
+// Struct fields are named after their json tags, `json:"-"` fields are skipped and embedded structs are inlined,
+// just like encoding/json does. Field types define whether the property is required:
+//   - ref.Ref[T] fields are required,
+//   - plain T fields are required unless tagged with omitempty (or omitzero),
+//   - *T and opt.Opt[T] fields are optional,
+//   - tri-state fields (opt.Opt[*T] and **T: absent, null or a value) are optional and nullable: `type: [T, null]`.
+//
+// Named structs are shared in `$defs` and referenced with `$ref`, generic instantiations get names like
+// "Page_User" for Page[User].
+package schema
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// Draft is the JSON Schema dialect of generated schemas.
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+// Schema is a JSON Schema, it contains only the keywords the generator uses.
+type Schema struct {
+	Schema               string             `json:"$schema,omitempty"`
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 Type               `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	ContentEncoding      string             `json:"contentEncoding,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	AnyOf                []*Schema          `json:"anyOf,omitempty"`
+	Defs                 map[string]*Schema `json:"$defs,omitempty"`
+}
+
+// Type lists JSON types of the value, a single type is encoded as a string: "string" or ["string", "null"].
+type Type []string
+
+// MarshalJSON implements json.Marshaler.
+func (t Type) MarshalJSON() ([]byte, error) {
+	if len(t) == 1 {
+		return json.Marshal(t[0])
+	}
+
+	return json.Marshal([]string(t))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *Type) UnmarshalJSON(data []byte) error {
+	var single string
+	if json.Unmarshal(data, &single) == nil {
+		*t = Type{single}
+
+		return nil
+	}
+
+	return json.Unmarshal(data, (*[]string)(t))
+}
+
+// For generates the schema of the type T.
+func For[T any]() *Schema {
+	return Reflect(reflect.TypeFor[T]())
+}
+
+// Reflect generates the schema of the reflected type t.
+func Reflect(t reflect.Type) *Schema {
+	g := generator{names: make(map[reflect.Type]string), defs: make(map[string]*Schema)}
+
+	root := *g.schemaOf(t)
+	root.Schema = Draft
+
+	if len(g.defs) > 0 {
+		root.Defs = g.defs
+	}
+
+	return &root
+}
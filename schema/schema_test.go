@@ -0,0 +1,131 @@
+package schema_test
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sr9000/go-ptr-tools/opt"
+	"github.com/sr9000/go-ptr-tools/ref"
+	"github.com/sr9000/go-ptr-tools/schema"
+)
+
+var update = flag.Bool("update", false, "update golden files") //nolint:gochecknoglobals // test flag
+
+type User struct {
+	ID      int64            `json:"id"`
+	Name    string           `json:"name"`
+	Email   *string          `json:"email"`
+	Nick    opt.Opt[string]  `json:"nick"`
+	Manager opt.Opt[*User]   `json:"manager"`
+	Avatar  []byte           `json:"avatar,omitempty"`
+	Created time.Time        `json:"created"`
+	Scores  [2]float64       `json:"scores"`
+	Extra   map[string]any   `json:"extra,omitempty"`
+	Secret  string           `json:"-"`
+	Roles   []opt.Opt[uint8] `json:"roles"`
+}
+
+type Page[T any] struct {
+	Items []T             `json:"items"`
+	Next  opt.Opt[string] `json:"next"`
+	Total ref.Ref[int]    `json:"total"`
+}
+
+type Pair[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+type Meta struct {
+	RequestID string          `json:"request_id"`
+	Labels    map[string]uint `json:"labels,omitempty"`
+}
+
+type Envelope[T any] struct {
+	Meta
+
+	Data  ref.Ref[T] `json:"data"`
+	Error **string   `json:"error"`
+}
+
+func TestReflect(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		golden string
+		schema *schema.Schema
+	}{
+		{"string", schema.For[string]()},
+		{"user", schema.For[User]()},
+		{"page_of_users", schema.For[Page[User]]()},
+		{"nested_generics", schema.For[Envelope[Page[Pair[string, opt.Opt[int]]]]]()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.golden, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := json.MarshalIndent(tt.schema, "", "  ")
+			require.NoError(t, err)
+
+			path := filepath.Join("testdata", tt.golden+".json")
+			if *update {
+				require.NoError(t, os.WriteFile(path, append(actual, '\n'), 0o600))
+			}
+
+			expected, err := os.ReadFile(path)
+			require.NoError(t, err)
+			require.JSONEq(t, string(expected), string(actual))
+
+			var decoded schema.Schema
+			require.NoError(t, json.Unmarshal(actual, &decoded))
+			require.Equal(t, tt.schema, &decoded, "round trip")
+		})
+	}
+}
+
+type Base struct {
+	Name  int    `json:"name"`
+	ID    string // conflicts with Audit.ID
+	Email string
+	Note  string `json:"note"`
+}
+
+type Audit struct {
+	ID    string
+	Email string `json:"Email"` // tagged, wins over Base.Email
+	Note  string `json:"note"`  // conflicts with Base.Note
+}
+
+type Account struct {
+	Base
+	*Audit
+
+	Name string `json:"name"` // shadows Base.Name
+}
+
+func TestEmbeddedFields(t *testing.T) {
+	t.Parallel()
+
+	s := schema.For[Account]()
+	account := s.Defs["Account"]
+	require.NotNil(t, account)
+
+	require.Equal(t, &schema.Schema{Type: schema.Type{"string"}}, account.Properties["name"])
+	require.Equal(t, []string{"Email", "name"}, account.Required)
+
+	// encoding/json agrees on the properties
+	data, err := json.Marshal(Account{Audit: &Audit{}})
+	require.NoError(t, err)
+
+	var encoded map[string]any
+	require.NoError(t, json.Unmarshal(data, &encoded))
+	require.Equal(t, map[string]any{"Email": "", "name": ""}, encoded)
+	require.Len(t, account.Properties, len(encoded))
+}
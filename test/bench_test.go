@@ -3,7 +3,9 @@ package test_test
 import (
 	"testing"
 
+	"github.com/sr9000/go-ptr-tools/maybe"
 	"github.com/sr9000/go-ptr-tools/opt"
+	"github.com/sr9000/go-ptr-tools/ptr"
 )
 
 func parsePrimeBool(number int) (int, bool) {
@@ -156,3 +158,102 @@ func BenchmarkAny(b *testing.B) {
 		}
 	}
 }
+
+func add(a, b int) int {
+	return a + b
+}
+
+// BenchmarkApply2 compares applying a function to a pointer and an option through the per-kind variants
+// (which need a conversion for mixed kinds) with maybe.Apply2 taking both as they are.
+func BenchmarkApply2(b *testing.B) {
+	b.Run("ptr", benchmarkApply2Ptr)
+	b.Run("opt", benchmarkApply2Opt)
+	b.Run("mixed ptr", benchmarkApply2MixedPtr)
+	b.Run("mixed opt", benchmarkApply2MixedOpt)
+	b.Run("mixed maybe", benchmarkApply2MixedMaybe)
+}
+
+func benchmarkApply2Ptr(b *testing.B) {
+	ptrs := ptrLoop()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for range b.N {
+		s := 0
+
+		for i := range testIters {
+			if r := ptr.Apply2(ptrs[i], ptrs[testIters-1-i], add); r != nil {
+				s += *r
+			}
+		}
+	}
+}
+
+func benchmarkApply2Opt(b *testing.B) {
+	opts := optLoop()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for range b.N {
+		s := 0
+
+		for i := range testIters {
+			if r, ok := opt.Apply2(opts[i], opts[testIters-1-i], add).Get(); ok {
+				s += r
+			}
+		}
+	}
+}
+
+func benchmarkApply2MixedPtr(b *testing.B) {
+	ptrs, opts := ptrLoop(), optLoop()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for range b.N {
+		s := 0
+
+		for i := range testIters {
+			if r := ptr.Apply2(ptrs[i], opts[testIters-1-i].Ptr(), add); r != nil {
+				s += *r
+			}
+		}
+	}
+}
+
+func benchmarkApply2MixedOpt(b *testing.B) {
+	ptrs, opts := ptrLoop(), optLoop()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for range b.N {
+		s := 0
+
+		for i := range testIters {
+			if r, ok := opt.Apply2(opt.FromPtr(ptrs[i]), opts[testIters-1-i], add).Get(); ok {
+				s += r
+			}
+		}
+	}
+}
+
+func benchmarkApply2MixedMaybe(b *testing.B) {
+	ptrs, opts := ptrLoop(), optLoop()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for range b.N {
+		s := 0
+
+		for i := range testIters {
+			if r, ok := maybe.Apply2(maybe.Ptr(ptrs[i]), opts[testIters-1-i], add).Get(); ok {
+				s += r
+			}
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package zero_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sr9000/go-ptr-tools/zero"
+)
+
+func join(a, b string) string { return a + " " + b }
+
+func TestApply(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		first, last string
+		expected    string
+	}{
+		{"both set", "Ada", "Lovelace", "Ada Lovelace"},
+		{"first zero", "", "Lovelace", ""},
+		{"last zero", "Ada", "", ""},
+		{"both zero", "", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.expected, zero.Apply2(tt.first, tt.last, join))
+			require.Equal(t, tt.expected, zero.Monad2(join)(tt.first, tt.last))
+		})
+	}
+}
+
+func TestApplyVariants(t *testing.T) {
+	t.Parallel()
+
+	t.Run("void", func(t *testing.T) {
+		t.Parallel()
+
+		var got []int
+
+		zero.ApplyVoid(1, func(v int) { got = append(got, v) })
+		zero.ApplyVoid(0, func(v int) { got = append(got, v) })
+		require.Equal(t, []int{1}, got)
+	})
+
+	t.Run("ctx err", func(t *testing.T) {
+		t.Parallel()
+
+		parse := func(_ context.Context, s string) (int, error) { return strconv.Atoi(s) }
+
+		n, err := zero.ApplyCtxErr(t.Context(), "42", parse)
+		require.NoError(t, err)
+		require.Equal(t, 42, n)
+
+		_, err = zero.ApplyCtxErr(t.Context(), "x", parse)
+
+		var numErr *strconv.NumError
+		require.ErrorAs(t, err, &numErr)
+
+		n, err = zero.MonadCtxErr(parse)(t.Context(), "")
+		require.NoError(t, err)
+		require.Zero(t, n)
+	})
+
+	t.Run("multiple results", func(t *testing.T) {
+		t.Parallel()
+
+		div := func(a, b int) (int, int) { return a / b, a % b }
+
+		q, r := zero.Apply22(7, 2, div)
+		require.Equal(t, 3, q)
+		require.Equal(t, 1, r)
+
+		q, r = zero.Apply22(7, 0, div)
+		require.Zero(t, q)
+		require.Zero(t, r)
+	})
+
+	t.Run("struct", func(t *testing.T) {
+		t.Parallel()
+
+		type point struct{ X, Y int }
+
+		norm := func(p point) int { return p.X*p.X + p.Y*p.Y }
+
+		require.Equal(t, 1, zero.Apply(point{Y: 1}, norm))
+		require.Zero(t, zero.Apply(point{}, norm))
+	})
+}
+
+func TestApplyAllocs(t *testing.T) { //nolint:paralleltest // AllocsPerRun panics in parallel tests
+	first, last := "Ada", "Lovelace"
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = zero.Apply2(first, last, func(a, b string) int { return len(a) + len(b) })
+	})
+	require.Zero(t, allocs)
+}
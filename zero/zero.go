@@ -0,0 +1,34 @@
+// Package zero treats zero values as missing ones, the way ptr.FromZero and opt.FromZero do,
+// which is how legacy structs and protobuf3 scalars mark absent fields.
+//
+// The generated Apply and Monad functions call fn only when every input is not zero,
+// and return zero values otherwise, so no value has to be wrapped in a pointer or an option first:
+//
+//	name := zero.Apply2(user.FirstName, user.LastName, fullName) // "" unless both are set
+package zero
+
+// Coalesce returns the first not zero value.
+// If all values are zero, it returns the zero value.
+func Coalesce[T comparable](values ...T) (r T) {
+	for _, v := range values {
+		if v != r {
+			return v
+		}
+	}
+
+	return
+}
+
+// Else returns the first not zero value.
+// If all values are zero, it returns the final value.
+func Else[T comparable](final T, values ...T) T {
+	var zero T
+
+	for _, v := range values {
+		if v != zero {
+			return v
+		}
+	}
+
+	return final
+}
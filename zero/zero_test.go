@@ -0,0 +1,65 @@
+package zero_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sr9000/go-ptr-tools/zero"
+)
+
+func TestCoalesce(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		expected string
+		values   []string
+	}{
+		{"no values", "", nil},
+		{"all zero", "", []string{"", ""}},
+		{"first", "a", []string{"a", "b"}},
+		{"skip zero", "b", []string{"", "b", "c"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.expected, zero.Coalesce(tt.values...))
+		})
+	}
+}
+
+func TestElse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		expected int
+		values   []int
+	}{
+		{"no values", -1, nil},
+		{"all zero", -1, []int{0, 0}},
+		{"first", 1, []int{1, 2}},
+		{"skip zero", 2, []int{0, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.expected, zero.Else(-1, tt.values...))
+		})
+	}
+}
+
+func TestCoalesceAllocs(t *testing.T) { //nolint:paralleltest // AllocsPerRun panics in parallel tests
+	a, b, c := 0, 0, 3
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = zero.Coalesce(a, b, c)
+		_ = zero.Else(1, a, b)
+	})
+	require.Zero(t, allocs)
+}